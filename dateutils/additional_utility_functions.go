@@ -16,86 +16,18 @@ import (
 //	ss   - 2-digit second (00-59)
 //	SSS  - 3-digit millisecond (000-999)
 //
+// Use CompileLightFormat to format repeatedly with the same pattern without allocating.
+//
 // Example:
 //
 //	LightFormat(time.Date(2019, 2, 11, 14, 0, 0, 0, time.UTC), "YYYY-MM-DD HH:mm:ss")
 //	// Returns: "2019-02-11 14:00:00"
 func LightFormat(t time.Time, format string) string {
-	if format == "" {
+	layout, err := CompileLightFormat(format)
+	if err != nil {
 		return ""
 	}
-
-	result := format
-
-	// Replace tokens with actual values
-	year := t.Year()
-	month := int(t.Month())
-	day := t.Day()
-	hour := t.Hour()
-	minute := t.Minute()
-	second := t.Second()
-	millisecond := t.Nanosecond() / 1000000
-
-	// Replace year
-	result = replaceToken(result, "YYYY", padZero(year, 4))
-
-	// Replace month
-	result = replaceToken(result, "MM", padZero(month, 2))
-
-	// Replace day
-	result = replaceToken(result, "DD", padZero(day, 2))
-
-	// Replace hour
-	result = replaceToken(result, "HH", padZero(hour, 2))
-
-	// Replace minute
-	result = replaceToken(result, "mm", padZero(minute, 2))
-
-	// Replace second
-	result = replaceToken(result, "ss", padZero(second, 2))
-
-	// Replace millisecond
-	result = replaceToken(result, "SSS", padZero(millisecond, 3))
-
-	return result
-}
-
-// replaceToken replaces all occurrences of token with value in the string
-func replaceToken(s, token, value string) string {
-	result := ""
-	tokenLen := len(token)
-	i := 0
-
-	for i < len(s) {
-		if i+tokenLen <= len(s) && s[i:i+tokenLen] == token {
-			result += value
-			i += tokenLen
-		} else {
-			result += string(s[i])
-			i++
-		}
-	}
-
-	return result
-}
-
-// padZero pads a number with leading zeros to the specified width
-func padZero(num, width int) string {
-	str := ""
-	if num == 0 {
-		str = "0"
-	} else {
-		for num > 0 {
-			str = string(rune('0'+(num%10))) + str
-			num /= 10
-		}
-	}
-
-	for len(str) < width {
-		str = "0" + str
-	}
-
-	return str
+	return layout.Format(t)
 }
 
 // RoundToNearestMinutes rounds the given date to the nearest specified number of minutes.
@@ -0,0 +1,1359 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Dialect is a date pattern syntax understood by ConvertPattern.
+type Dialect int
+
+const (
+	DialectGo       Dialect = iota + 1 // Go reference layouts: "2006-01-02 15:04:05"
+	DialectCustom                      // FormatCustom placeholders: "YYYY-MM-DD hh:mm AM/PM"
+	DialectLDML                        // Unicode LDML as in date-fns and FormatTokens: "yyyy-MM-dd HH:mm"
+	DialectMoment                      // Moment.js and Day.js: "YYYY-MM-DD HH:mm"
+	DialectJava                        // java.time DateTimeFormatter: "yyyy-MM-dd HH:mm"
+	DialectPHP                         // PHP date(): "Y-m-d H:i"
+	DialectStrftime                    // C strftime, as in Strftime: "%Y-%m-%d %H:%M"
+)
+
+// String returns the name of the dialect, such as "Moment".
+func (d Dialect) String() string {
+	switch d {
+	case DialectGo:
+		return "Go"
+	case DialectCustom:
+		return "FormatCustom"
+	case DialectLDML:
+		return "LDML"
+	case DialectMoment:
+		return "Moment"
+	case DialectJava:
+		return "Java"
+	case DialectPHP:
+		return "PHP"
+	case DialectStrftime:
+		return "strftime"
+	}
+	return fmt.Sprintf("Dialect(%d)", int(d))
+}
+
+// Warning describes a token that ConvertPattern converted, but not exactly:
+// the result formats it differently, or the token itself is a common mistake.
+type Warning struct {
+	Token   string // The token in the source pattern
+	Offset  int    // Byte offset of the token in the source pattern
+	Message string // What differs
+}
+
+// String returns the warning with its token and offset.
+func (w Warning) String() string {
+	return fmt.Sprintf("%q at offset %d: %s", w.Token, w.Offset, w.Message)
+}
+
+// patternField is what a pattern token writes, independently of its dialect.
+type patternField uint8
+
+const (
+	fieldLiteral patternField = iota
+	fieldEra
+	fieldYear
+	fieldYearShort
+	fieldISOWeekYear
+	fieldISOWeekYearShort
+	fieldWeekYear
+	fieldWeekYearShort
+	fieldQuarter
+	fieldMonth
+	fieldMonthPad
+	fieldMonthShort
+	fieldMonthLong
+	fieldDay
+	fieldDayPad
+	fieldDaySpace
+	fieldDayOrdinal
+	fieldDayOfYear
+	fieldDayOfYearPad
+	fieldWeekdayShort
+	fieldWeekdayLong
+	fieldISOWeekday
+	fieldWeekday
+	fieldISOWeek
+	fieldISOWeekPad
+	fieldWeek
+	fieldWeekPad
+	fieldHour24
+	fieldHour24Pad
+	fieldHour12
+	fieldHour12Pad
+	fieldMinute
+	fieldMinutePad
+	fieldSecond
+	fieldSecondPad
+	fieldFraction
+	fieldFractionTrimmed
+	fieldMeridiem
+	fieldMeridiemLower
+	fieldOffset
+	fieldOffsetColon
+	fieldOffsetHours
+	fieldOffsetZ
+	fieldOffsetColonZ
+	fieldOffsetHoursZ
+	fieldZone
+	fieldUnix
+	fieldUnixMillis
+)
+
+// fieldNames describes each field in warnings.
+var fieldNames = [...]string{
+	fieldLiteral:          "literal text",
+	fieldEra:              "era (AD)",
+	fieldYear:             "year (2024)",
+	fieldYearShort:        "two-digit year (24)",
+	fieldISOWeekYear:      "ISO week-numbering year",
+	fieldISOWeekYearShort: "two-digit ISO week-numbering year",
+	fieldWeekYear:         "locale week-numbering year",
+	fieldWeekYearShort:    "two-digit locale week-numbering year",
+	fieldQuarter:          "quarter (1)",
+	fieldMonth:            "month (3)",
+	fieldMonthPad:         "zero-padded month (03)",
+	fieldMonthShort:       "abbreviated month name (Mar)",
+	fieldMonthLong:        "month name (March)",
+	fieldDay:              "day of the month (5)",
+	fieldDayPad:           "zero-padded day of the month (05)",
+	fieldDaySpace:         "space-padded day of the month ( 5)",
+	fieldDayOrdinal:       "ordinal day of the month (5th)",
+	fieldDayOfYear:        "day of the year (65)",
+	fieldDayOfYearPad:     "zero-padded day of the year (065)",
+	fieldWeekdayShort:     "abbreviated weekday name (Tue)",
+	fieldWeekdayLong:      "weekday name (Tuesday)",
+	fieldISOWeekday:       "ISO weekday number (Monday=1)",
+	fieldWeekday:          "weekday number (Sunday=0)",
+	fieldISOWeek:          "ISO week of the year",
+	fieldISOWeekPad:       "zero-padded ISO week of the year",
+	fieldWeek:             "locale week of the year",
+	fieldWeekPad:          "zero-padded locale week of the year",
+	fieldHour24:           "hour (0-23)",
+	fieldHour24Pad:        "zero-padded hour (00-23)",
+	fieldHour12:           "hour (1-12)",
+	fieldHour12Pad:        "zero-padded hour (01-12)",
+	fieldMinute:           "minute (4)",
+	fieldMinutePad:        "zero-padded minute (04)",
+	fieldSecond:           "second (5)",
+	fieldSecondPad:        "zero-padded second (05)",
+	fieldFraction:         "fraction of a second",
+	fieldFractionTrimmed:  "fraction of a second without trailing zeros",
+	fieldMeridiem:         "meridiem (PM)",
+	fieldMeridiemLower:    "lower-case meridiem (pm)",
+	fieldOffset:           "offset (-0700)",
+	fieldOffsetColon:      "offset (-07:00)",
+	fieldOffsetHours:      "offset hours (-07)",
+	fieldOffsetZ:          "offset with Z for UTC (-0700)",
+	fieldOffsetColonZ:     "offset with Z for UTC (-07:00)",
+	fieldOffsetHoursZ:     "offset hours with Z for UTC (-07)",
+	fieldZone:             "zone abbreviation (MST)",
+	fieldUnix:             "Unix timestamp in seconds",
+	fieldUnixMillis:       "Unix timestamp in milliseconds",
+}
+
+// fieldFallbacks lists, in order of preference, the fields written instead
+// of a field the target dialect has no token for.
+var fieldFallbacks = map[patternField][]patternField{
+	fieldYearShort:        {fieldYear},
+	fieldISOWeekYear:      {fieldWeekYear, fieldYear},
+	fieldISOWeekYearShort: {fieldISOWeekYear, fieldWeekYearShort, fieldWeekYear, fieldYearShort},
+	fieldWeekYear:         {fieldISOWeekYear, fieldYear},
+	fieldWeekYearShort:    {fieldWeekYear, fieldISOWeekYearShort, fieldISOWeekYear, fieldYearShort},
+	fieldMonth:            {fieldMonthPad},
+	fieldMonthPad:         {fieldMonth},
+	fieldDay:              {fieldDayPad},
+	fieldDayPad:           {fieldDay},
+	fieldDaySpace:         {fieldDay, fieldDayPad},
+	fieldDayOrdinal:       {fieldDay, fieldDayPad},
+	fieldDayOfYear:        {fieldDayOfYearPad},
+	fieldDayOfYearPad:     {fieldDayOfYear},
+	fieldISOWeekday:       {fieldWeekday},
+	fieldWeekday:          {fieldISOWeekday},
+	fieldISOWeek:          {fieldISOWeekPad, fieldWeek, fieldWeekPad},
+	fieldISOWeekPad:       {fieldISOWeek, fieldWeekPad, fieldWeek},
+	fieldWeek:             {fieldWeekPad, fieldISOWeek, fieldISOWeekPad},
+	fieldWeekPad:          {fieldWeek, fieldISOWeekPad, fieldISOWeek},
+	fieldHour24:           {fieldHour24Pad},
+	fieldHour24Pad:        {fieldHour24},
+	fieldHour12:           {fieldHour12Pad},
+	fieldHour12Pad:        {fieldHour12},
+	fieldMinute:           {fieldMinutePad},
+	fieldMinutePad:        {fieldMinute},
+	fieldSecond:           {fieldSecondPad},
+	fieldSecondPad:        {fieldSecond},
+	fieldFractionTrimmed:  {fieldFraction},
+	fieldMeridiem:         {fieldMeridiemLower},
+	fieldMeridiemLower:    {fieldMeridiem},
+	fieldOffset:           {fieldOffsetZ, fieldOffsetColon, fieldOffsetColonZ},
+	fieldOffsetColon:      {fieldOffsetColonZ, fieldOffset, fieldOffsetZ},
+	fieldOffsetHours:      {fieldOffsetHoursZ, fieldOffset, fieldOffsetZ},
+	fieldOffsetZ:          {fieldOffset, fieldOffsetColonZ, fieldOffsetColon},
+	fieldOffsetColonZ:     {fieldOffsetColon, fieldOffsetZ, fieldOffset},
+	fieldOffsetHoursZ:     {fieldOffsetHours, fieldOffsetZ, fieldOffset},
+	fieldZone:             {fieldOffsetColon, fieldOffset},
+	fieldUnixMillis:       {fieldUnix},
+}
+
+// patternElement is a token of a source pattern, read as a field.
+type patternElement struct {
+	field   patternField
+	digits  int    // Digits of fieldFraction and fieldFractionTrimmed
+	literal string // Text of fieldLiteral
+	token   string // The token in the source pattern
+	offset  int    // Byte offset of the token in the source pattern
+	note    string // Set when the token is a common mistake or has no exact field
+}
+
+// dialectToken is a token of a dialect and the field it writes. A note marks
+// tokens that only approximate the field; it is reported in both directions.
+type dialectToken struct {
+	text  string
+	field patternField
+	note  string
+}
+
+// goTokens are the elements of Go reference layouts.
+var goTokens = []dialectToken{
+	{"2006", fieldYear, ""},
+	{"06", fieldYearShort, ""},
+	{"January", fieldMonthLong, ""},
+	{"Jan", fieldMonthShort, ""},
+	{"01", fieldMonthPad, ""},
+	{"1", fieldMonth, ""},
+	{"Monday", fieldWeekdayLong, ""},
+	{"Mon", fieldWeekdayShort, ""},
+	{"MST", fieldZone, ""},
+	{"002", fieldDayOfYearPad, ""},
+	{"02", fieldDayPad, ""},
+	{"__2", fieldDayOfYear, "Go pads the day of the year with spaces"},
+	{"_2", fieldDaySpace, ""},
+	{"2", fieldDay, ""},
+	{"15", fieldHour24Pad, ""},
+	{"03", fieldHour12Pad, ""},
+	{"3", fieldHour12, ""},
+	{"04", fieldMinutePad, ""},
+	{"4", fieldMinute, ""},
+	{"05", fieldSecondPad, ""},
+	{"5", fieldSecond, ""},
+	{"PM", fieldMeridiem, ""},
+	{"pm", fieldMeridiemLower, ""},
+	{"Z07:00:00", fieldOffsetColonZ, "the seconds of the offset are dropped"},
+	{"Z070000", fieldOffsetZ, "the seconds of the offset are dropped"},
+	{"Z07:00", fieldOffsetColonZ, ""},
+	{"Z0700", fieldOffsetZ, ""},
+	{"Z07", fieldOffsetHoursZ, ""},
+	{"-07:00:00", fieldOffsetColon, "the seconds of the offset are dropped"},
+	{"-070000", fieldOffset, "the seconds of the offset are dropped"},
+	{"-07:00", fieldOffsetColon, ""},
+	{"-0700", fieldOffset, ""},
+	{"-07", fieldOffsetHours, ""},
+}
+
+// customTokens are the FormatCustom placeholders, in replacement order.
+var customTokens = []dialectToken{
+	{"YYYY", fieldYear, ""},
+	{"AM/PM", fieldMeridiem, ""},
+	{"YY", fieldYearShort, ""},
+	{"MM", fieldMonthPad, ""},
+	{"DD", fieldDayPad, ""},
+	{"HH", fieldHour24Pad, ""},
+	{"hh", fieldHour12Pad, ""},
+	{"mm", fieldMinutePad, ""},
+	{"ss", fieldSecondPad, ""},
+}
+
+// ldmlTokens are the date-fns tokens written for each field.
+var ldmlTokens = []dialectToken{
+	{"G", fieldEra, ""},
+	{"yyyy", fieldYear, ""},
+	{"yy", fieldYearShort, ""},
+	{"RRRR", fieldISOWeekYear, ""},
+	{"YYYY", fieldWeekYear, "FormatTokens needs UseAdditionalWeekYearTokens for YYYY"},
+	{"YY", fieldWeekYearShort, "FormatTokens needs UseAdditionalWeekYearTokens for YY"},
+	{"Q", fieldQuarter, ""},
+	{"M", fieldMonth, ""},
+	{"MM", fieldMonthPad, ""},
+	{"MMM", fieldMonthShort, ""},
+	{"MMMM", fieldMonthLong, ""},
+	{"d", fieldDay, ""},
+	{"dd", fieldDayPad, ""},
+	{"do", fieldDayOrdinal, ""},
+	{"D", fieldDayOfYear, "FormatTokens needs UseAdditionalDayOfYearTokens for D"},
+	{"DDD", fieldDayOfYearPad, ""},
+	{"EEE", fieldWeekdayShort, ""},
+	{"EEEE", fieldWeekdayLong, ""},
+	{"i", fieldISOWeekday, ""},
+	{"I", fieldISOWeek, ""},
+	{"II", fieldISOWeekPad, ""},
+	{"w", fieldWeek, ""},
+	{"ww", fieldWeekPad, ""},
+	{"H", fieldHour24, ""},
+	{"HH", fieldHour24Pad, ""},
+	{"h", fieldHour12, ""},
+	{"hh", fieldHour12Pad, ""},
+	{"m", fieldMinute, ""},
+	{"mm", fieldMinutePad, ""},
+	{"s", fieldSecond, ""},
+	{"ss", fieldSecondPad, ""},
+	{"a", fieldMeridiem, ""},
+	{"aaa", fieldMeridiemLower, ""},
+	{"xx", fieldOffset, ""},
+	{"xxx", fieldOffsetColon, ""},
+	{"x", fieldOffsetHours, ""},
+	{"XX", fieldOffsetZ, ""},
+	{"XXX", fieldOffsetColonZ, ""},
+	{"X", fieldOffsetHoursZ, ""},
+	{"zzz", fieldZone, "FormatTokens and date-fns write z as a GMT offset (GMT-7), not a zone abbreviation"},
+	{"t", fieldUnix, ""},
+	{"T", fieldUnixMillis, ""},
+}
+
+// javaTokens are the DateTimeFormatter letters written for each field.
+var javaTokens = []dialectToken{
+	{"G", fieldEra, ""},
+	{"yyyy", fieldYear, ""},
+	{"yy", fieldYearShort, ""},
+	{"YYYY", fieldWeekYear, ""},
+	{"YY", fieldWeekYearShort, ""},
+	{"Q", fieldQuarter, ""},
+	{"M", fieldMonth, ""},
+	{"MM", fieldMonthPad, ""},
+	{"MMM", fieldMonthShort, ""},
+	{"MMMM", fieldMonthLong, ""},
+	{"d", fieldDay, ""},
+	{"dd", fieldDayPad, ""},
+	{"D", fieldDayOfYear, ""},
+	{"DDD", fieldDayOfYearPad, ""},
+	{"EEE", fieldWeekdayShort, ""},
+	{"EEEE", fieldWeekdayLong, ""},
+	{"e", fieldWeekday, "Java numbers the weekday from the formatter locale's first day of the week"},
+	{"w", fieldWeek, ""},
+	{"ww", fieldWeekPad, ""},
+	{"H", fieldHour24, ""},
+	{"HH", fieldHour24Pad, ""},
+	{"h", fieldHour12, ""},
+	{"hh", fieldHour12Pad, ""},
+	{"m", fieldMinute, ""},
+	{"mm", fieldMinutePad, ""},
+	{"s", fieldSecond, ""},
+	{"ss", fieldSecondPad, ""},
+	{"a", fieldMeridiem, ""},
+	{"xx", fieldOffset, ""},
+	{"xxx", fieldOffsetColon, ""},
+	{"x", fieldOffsetHours, ""},
+	{"XX", fieldOffsetZ, ""},
+	{"XXX", fieldOffsetColonZ, ""},
+	{"X", fieldOffsetHoursZ, ""},
+	{"z", fieldZone, ""},
+}
+
+// momentTokens are the Moment.js tokens. Fractions of a second (S to SSSSSSSSS) are read separately.
+var momentTokens = []dialectToken{
+	{"YYYYYY", fieldYear, "Moment writes a signed six-digit year for YYYYYY"},
+	{"YYYY", fieldYear, ""},
+	{"YY", fieldYearShort, ""},
+	{"Y", fieldYear, ""},
+	{"gggg", fieldWeekYear, ""},
+	{"gg", fieldWeekYearShort, ""},
+	{"GGGG", fieldISOWeekYear, ""},
+	{"GG", fieldISOWeekYearShort, ""},
+	{"Qo", fieldQuarter, "the ordinal suffix is dropped"},
+	{"Q", fieldQuarter, ""},
+	{"MMMM", fieldMonthLong, ""},
+	{"MMM", fieldMonthShort, ""},
+	{"Mo", fieldMonth, "the ordinal suffix is dropped"},
+	{"MM", fieldMonthPad, ""},
+	{"M", fieldMonth, ""},
+	{"DDDD", fieldDayOfYearPad, ""},
+	{"DDDo", fieldDayOfYear, "the ordinal suffix is dropped"},
+	{"DDD", fieldDayOfYear, ""},
+	{"Do", fieldDayOrdinal, ""},
+	{"DD", fieldDayPad, ""},
+	{"D", fieldDay, ""},
+	{"dddd", fieldWeekdayLong, ""},
+	{"ddd", fieldWeekdayShort, ""},
+	{"dd", fieldWeekdayShort, "Moment writes two-letter weekday names (Tu) for dd"},
+	{"do", fieldWeekday, "the ordinal suffix is dropped"},
+	{"d", fieldWeekday, ""},
+	{"E", fieldISOWeekday, ""},
+	{"e", fieldWeekday, "Moment numbers the weekday from the locale's first day of the week"},
+	{"WW", fieldISOWeekPad, ""},
+	{"Wo", fieldISOWeek, "the ordinal suffix is dropped"},
+	{"W", fieldISOWeek, ""},
+	{"ww", fieldWeekPad, ""},
+	{"wo", fieldWeek, "the ordinal suffix is dropped"},
+	{"w", fieldWeek, ""},
+	{"HH", fieldHour24Pad, ""},
+	{"H", fieldHour24, ""},
+	{"hh", fieldHour12Pad, ""},
+	{"h", fieldHour12, ""},
+	{"kk", fieldHour24Pad, "Moment writes midnight as 24 for kk"},
+	{"k", fieldHour24, "Moment writes midnight as 24 for k"},
+	{"mm", fieldMinutePad, ""},
+	{"m", fieldMinute, ""},
+	{"ss", fieldSecondPad, ""},
+	{"s", fieldSecond, ""},
+	{"A", fieldMeridiem, ""},
+	{"a", fieldMeridiemLower, ""},
+	{"ZZ", fieldOffset, ""},
+	{"Z", fieldOffsetColon, ""},
+	{"zz", fieldZone, "Moment needs moment-timezone to write zone abbreviations"},
+	{"z", fieldZone, "Moment needs moment-timezone to write zone abbreviations"},
+	{"X", fieldUnix, ""},
+	{"x", fieldUnixMillis, ""},
+	{"NNNNN", fieldEra, "Moment writes narrow era names for NNNNN"},
+	{"NNNN", fieldEra, "Moment writes full era names for NNNN"},
+	{"NNN", fieldEra, ""},
+	{"NN", fieldEra, ""},
+	{"N", fieldEra, ""},
+}
+
+// phpTokens are the PHP date() letters. S, the English ordinal suffix, and
+// the composite c and r letters are read separately.
+var phpTokens = []dialectToken{
+	{"Y", fieldYear, ""},
+	{"y", fieldYearShort, ""},
+	{"o", fieldISOWeekYear, ""},
+	{"X", fieldYear, "PHP always writes a sign and at least four digits for X"},
+	{"x", fieldYear, "PHP writes a sign for years after 9999 for x"},
+	{"n", fieldMonth, ""},
+	{"m", fieldMonthPad, ""},
+	{"M", fieldMonthShort, ""},
+	{"F", fieldMonthLong, ""},
+	{"j", fieldDay, ""},
+	{"d", fieldDayPad, ""},
+	{"jS", fieldDayOrdinal, ""},
+	{"z", fieldDayOfYear, "PHP counts the day of the year from 0"},
+	{"D", fieldWeekdayShort, ""},
+	{"l", fieldWeekdayLong, ""},
+	{"N", fieldISOWeekday, ""},
+	{"w", fieldWeekday, ""},
+	{"W", fieldISOWeekPad, ""},
+	{"G", fieldHour24, ""},
+	{"H", fieldHour24Pad, ""},
+	{"g", fieldHour12, ""},
+	{"h", fieldHour12Pad, ""},
+	{"i", fieldMinutePad, ""},
+	{"s", fieldSecondPad, ""},
+	{"A", fieldMeridiem, ""},
+	{"a", fieldMeridiemLower, ""},
+	{"O", fieldOffset, ""},
+	{"P", fieldOffsetColon, ""},
+	{"p", fieldOffsetColonZ, ""},
+	{"T", fieldZone, ""},
+	{"e", fieldZone, "PHP writes the zone identifier (America/Sao_Paulo) for e"},
+	{"U", fieldUnix, ""},
+}
+
+// phpComposites are the PHP date() letters that stand for a whole pattern.
+var phpComposites = map[byte]string{
+	'c': `Y-m-d\TH:i:sP`,
+	'r': "D, d M Y H:i:s O",
+}
+
+// strftimeTokens are the conversions written for each field.
+var strftimeTokens = []dialectToken{
+	{"%Y", fieldYear, ""},
+	{"%y", fieldYearShort, ""},
+	{"%G", fieldISOWeekYear, ""},
+	{"%g", fieldISOWeekYearShort, ""},
+	{"%-m", fieldMonth, ""},
+	{"%m", fieldMonthPad, ""},
+	{"%b", fieldMonthShort, ""},
+	{"%B", fieldMonthLong, ""},
+	{"%-d", fieldDay, ""},
+	{"%d", fieldDayPad, ""},
+	{"%e", fieldDaySpace, ""},
+	{"%-j", fieldDayOfYear, ""},
+	{"%j", fieldDayOfYearPad, ""},
+	{"%a", fieldWeekdayShort, ""},
+	{"%A", fieldWeekdayLong, ""},
+	{"%u", fieldISOWeekday, ""},
+	{"%w", fieldWeekday, ""},
+	{"%-V", fieldISOWeek, ""},
+	{"%V", fieldISOWeekPad, ""},
+	{"%U", fieldWeekPad, "strftime counts %U weeks from the first Sunday, starting at 00"},
+	{"%-H", fieldHour24, ""},
+	{"%H", fieldHour24Pad, ""},
+	{"%-I", fieldHour12, ""},
+	{"%I", fieldHour12Pad, ""},
+	{"%-M", fieldMinute, ""},
+	{"%M", fieldMinutePad, ""},
+	{"%-S", fieldSecond, ""},
+	{"%S", fieldSecondPad, ""},
+	{"%p", fieldMeridiem, ""},
+	{"%P", fieldMeridiemLower, ""},
+	{"%z", fieldOffset, ""},
+	{"%:z", fieldOffsetColon, ""},
+	{"%Z", fieldZone, ""},
+	{"%s", fieldUnix, ""},
+}
+
+// dialectTokens returns the tokens a dialect writes fields with.
+// FormatCustom passes everything but its placeholders to time.Format, so
+// it also writes the Go layout elements.
+func dialectTokens(d Dialect) []dialectToken {
+	switch d {
+	case DialectGo:
+		return goTokens
+	case DialectCustom:
+		return append(append([]dialectToken{}, customTokens...), goTokens...)
+	case DialectLDML:
+		return ldmlTokens
+	case DialectMoment:
+		return momentTokens
+	case DialectJava:
+		return javaTokens
+	case DialectPHP:
+		return phpTokens
+	case DialectStrftime:
+		return strftimeTokens
+	}
+	return nil
+}
+
+// ConvertPattern rewrites a date pattern from one dialect to another, so a
+// single stored pattern can drive Format, FormatTokens, Strftime and the
+// Moment, date-fns, Java or PHP code of other services. Literal text is
+// escaped the way the target dialect needs: quoted in LDML and Java,
+// bracketed in Moment, backslashed in PHP and doubled % in strftime.
+//
+// Each Warning reports a token that converts inexactly, such as an ordinal
+// day ("do") written as a plain day in Go, or a padded hour written
+// unpadded, and tokens that are frequent mistakes, such as the LDML
+// week-numbering year "YYYY" and day of the year "DD". Go layouts and
+// FormatCustom cannot escape text, so literals they would read as layout
+// elements are reported too.
+// Returns a *TokenError wrapping ErrUnknownToken for tokens the source
+// dialect does not define, an error wrapping errors.ErrUnsupported for fields
+// the target dialect cannot write at all (such as a Unix timestamp in Go),
+// or an error wrapping ErrEmptyFormat.
+//
+// Example:
+//
+//	ConvertPattern("2006-01-02 15:04", DialectGo, DialectMoment)       // "YYYY-MM-DD HH:mm", no warnings
+//	ConvertPattern("EEEE, MMMM do yyyy", DialectLDML, DialectPHP)      // "l, F jS Y", no warnings
+//	ConvertPattern("YYYY-MM-DD", DialectLDML, DialectGo)
+//	// "2006-01-002", with warnings that YYYY is the week-numbering year and DD the day of the year
+func ConvertPattern(pattern string, from, to Dialect) (string, []Warning, error) {
+	if pattern == "" {
+		return "", nil, fmt.Errorf("cannot convert pattern: %w", ErrEmptyFormat)
+	}
+	if dialectTokens(to) == nil {
+		return "", nil, fmt.Errorf("unknown dialect %d", to)
+	}
+
+	var elements []patternElement
+	var err error
+	switch from {
+	case DialectGo:
+		elements = readGoLayout(pattern, 0)
+	case DialectCustom:
+		elements = readCustomPattern(pattern)
+	case DialectLDML:
+		elements, err = readLDMLPattern(pattern, false)
+	case DialectJava:
+		elements, err = readLDMLPattern(pattern, true)
+	case DialectMoment:
+		elements = readMomentPattern(pattern)
+	case DialectPHP:
+		elements, err = readPHPPattern(pattern, 0)
+	case DialectStrftime:
+		elements, err = readStrftimePattern(pattern, pattern, 0)
+	default:
+		return "", nil, fmt.Errorf("unknown dialect %d", from)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	var warnings []Warning
+	for _, el := range elements {
+		if el.note != "" {
+			warnings = append(warnings, Warning{Token: el.token, Offset: el.offset, Message: el.note})
+		}
+	}
+	result, renderWarnings, err := writePattern(elements, to)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Tokens expanded into several fields, such as "PPpp" or "%c", are reported once.
+	unique := warnings[:0]
+	for _, w := range append(warnings, renderWarnings...) {
+		if !slices.Contains(unique, w) {
+			unique = append(unique, w)
+		}
+	}
+	return result, unique, nil
+}
+
+// writePattern writes the elements with the tokens of the target dialect.
+func writePattern(elements []patternElement, to Dialect) (string, []Warning, error) {
+	tokens := dialectTokens(to)
+	var warnings []Warning
+	warn := func(el patternElement, format string, args ...any) {
+		warnings = append(warnings, Warning{Token: el.token, Offset: el.offset, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var dst []byte
+	for _, el := range elements {
+		if el.field == fieldLiteral {
+			if (to == DialectGo || to == DialectCustom) && literalHasTokens(el.literal, to) {
+				warn(el, "%s cannot escape the literal %q, which it reads as layout elements", to, el.literal)
+			}
+			dst = append(dst, quoteLiteral(el.literal, to)...)
+			continue
+		}
+
+		if el.field == fieldFraction || el.field == fieldFractionTrimmed {
+			dst = appendFractionToken(dst, el, to, warn)
+			continue
+		}
+
+		text, used, note, ok := lookupField(tokens, el.field)
+		for _, fallback := range fieldFallbacks[el.field] {
+			if ok {
+				break
+			}
+			text, used, note, ok = lookupField(tokens, fallback)
+		}
+		if !ok {
+			return "", nil, fmt.Errorf("cannot write the %s of %q at offset %d in %s: %w",
+				fieldNames[el.field], el.token, el.offset, to, errors.ErrUnsupported)
+		}
+		if used != el.field {
+			warn(el, "%s has no token for the %s; using %q, the %s", to, fieldNames[el.field], text, fieldNames[used])
+		}
+		if note != "" {
+			warn(el, "%s", note)
+		}
+		dst = append(dst, text...)
+	}
+	return string(dst), warnings, nil
+}
+
+// lookupField returns the first token of a dialect that writes a field,
+// preferring tokens that write it exactly.
+func lookupField(tokens []dialectToken, field patternField) (string, patternField, string, bool) {
+	var found *dialectToken
+	for i, tok := range tokens {
+		if tok.field != field {
+			continue
+		}
+		if tok.note == "" {
+			return tok.text, tok.field, "", true
+		}
+		if found == nil {
+			found = &tokens[i]
+		}
+	}
+	if found == nil {
+		return "", field, "", false
+	}
+	return found.text, found.field, found.note, true
+}
+
+// appendFractionToken appends a fraction of a second in the target dialect.
+func appendFractionToken(dst []byte, el patternElement, to Dialect, warn func(patternElement, string, ...any)) []byte {
+	digits := el.digits
+	if el.field == fieldFractionTrimmed && to != DialectGo && to != DialectCustom {
+		warn(el, "%s has no token for the %s; trailing zeros are kept", to, fieldNames[el.field])
+	}
+
+	switch to {
+	case DialectGo, DialectCustom:
+		// Go reads fractions only as part of ".000" or ",000", so the
+		// separator written before the fraction becomes part of it.
+		digit := byte('0')
+		if el.field == fieldFractionTrimmed {
+			digit = '9'
+		}
+		separator := byte('.')
+		if n := len(dst); n > 0 && (dst[n-1] == '.' || dst[n-1] == ',') {
+			separator = dst[n-1]
+			dst = dst[:n-1]
+		} else {
+			warn(el, "Go writes fractions of a second after a '.' or ','; a '.' is added")
+		}
+		dst = append(dst, separator)
+		for range digits {
+			dst = append(dst, digit)
+		}
+		return dst
+	case DialectPHP:
+		if digits != 3 && digits != 6 {
+			warn(el, "PHP writes fractions of a second with 3 (v) or 6 (u) digits, not %d", digits)
+		}
+		if digits <= 3 {
+			return append(dst, 'v')
+		}
+		return append(dst, 'u')
+	case DialectStrftime:
+		switch digits {
+		case 6:
+			return append(dst, "%f"...)
+		case 9:
+			return append(dst, "%N"...)
+		}
+		return append(dst, fmt.Sprintf("%%%dN", digits)...)
+	}
+	return append(dst, strings.Repeat("S", digits)...)
+}
+
+// quoteLiteral escapes literal text for the target dialect. In LDML, Java
+// and Moment only the span from the first to the last character that needs
+// escaping is quoted, so "-W" becomes -'W'.
+func quoteLiteral(literal string, to Dialect) string {
+	special := ""
+	switch to {
+	case DialectLDML:
+		special = "'"
+	case DialectJava:
+		special = "'[]{}#"
+	case DialectMoment:
+		special = "[]"
+	case DialectPHP:
+		var b strings.Builder
+		for i := 0; i < len(literal); i++ {
+			if isASCIILetter(literal[i]) || literal[i] == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(literal[i])
+		}
+		return b.String()
+	case DialectStrftime:
+		return strings.ReplaceAll(literal, "%", "%%")
+	default:
+		return literal
+	}
+
+	needsQuote := func(c byte) bool { return isASCIILetter(c) || strings.IndexByte(special, c) >= 0 }
+	first, last := -1, -1
+	for i := 0; i < len(literal); i++ {
+		if needsQuote(literal[i]) {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		return literal
+	}
+	span := literal[first : last+1]
+	switch {
+	case to == DialectMoment:
+		span = "[" + span + "]"
+	case span == "'":
+		span = "''"
+	default:
+		span = "'" + strings.ReplaceAll(span, "'", "''") + "'"
+	}
+	return literal[:first] + span + literal[last+1:]
+}
+
+// literalHasTokens reports whether Go or FormatCustom would read part of a
+// literal as a layout element.
+func literalHasTokens(literal string, to Dialect) bool {
+	elements := readGoLayout(literal, 0)
+	if to == DialectCustom {
+		elements = readCustomPattern(literal)
+	}
+	for _, el := range elements {
+		if el.field != fieldLiteral {
+			return true
+		}
+	}
+	return false
+}
+
+// appendLiteralElement appends literal text, merging it with a preceding literal.
+func appendLiteralElement(elements []patternElement, text string, offset int) []patternElement {
+	if n := len(elements); n > 0 && elements[n-1].field == fieldLiteral {
+		elements[n-1].literal += text
+		elements[n-1].token += text
+		return elements
+	}
+	return append(elements, patternElement{literal: text, token: text, offset: offset})
+}
+
+// matchDialectToken returns the longest token of the list that pattern[i:]
+// starts with; among tokens of the same length the first one wins.
+func matchDialectToken(tokens []dialectToken, pattern string, i int) (dialectToken, bool) {
+	var best dialectToken
+	for _, tok := range tokens {
+		if len(tok.text) > len(best.text) && strings.HasPrefix(pattern[i:], tok.text) {
+			best = tok
+		}
+	}
+	return best, best.text != ""
+}
+
+// readGoLayout reads a Go reference layout; base is added to the offsets.
+// As in the time package, fractions are a '.' or ',' followed by zeros or
+// nines that is not followed by another digit.
+func readGoLayout(layout string, base int) []patternElement {
+	var elements []patternElement
+	for i := 0; i < len(layout); {
+		c := layout[i]
+		if c == '.' || c == ',' {
+			j := i + 1
+			for j < len(layout) && layout[j] == layout[i+1] && (layout[j] == '0' || layout[j] == '9') {
+				j++
+			}
+			if j > i+1 && (j == len(layout) || !isDigit(layout[j])) {
+				field := fieldFraction
+				if layout[i+1] == '9' {
+					field = fieldFractionTrimmed
+				}
+				elements = appendLiteralElement(elements, layout[i:i+1], base+i)
+				elements = append(elements, patternElement{field: field, digits: j - i - 1, token: layout[i:j], offset: base + i})
+				i = j
+				continue
+			}
+		}
+		// "_2006" is an underscore before the year, not a padded day.
+		if c == '_' && strings.HasPrefix(layout[i+1:], "2006") {
+			elements = appendLiteralElement(elements, "_", base+i)
+			i++
+			continue
+		}
+		if tok, ok := matchDialectToken(goTokens, layout, i); ok {
+			elements = append(elements, patternElement{field: tok.field, token: tok.text, offset: base + i, note: tok.note})
+			i += len(tok.text)
+			continue
+		}
+		elements = appendLiteralElement(elements, layout[i:i+1], base+i)
+		i++
+	}
+	return elements
+}
+
+// readCustomPattern reads a FormatCustom pattern. The text between its
+// placeholders is passed to time.Format, so it is read as a Go layout.
+func readCustomPattern(pattern string) []patternElement {
+	var elements []patternElement
+	start := 0
+	for i := 0; i < len(pattern); {
+		tok, ok := matchDialectToken(customTokens, pattern, i)
+		if !ok {
+			i++
+			continue
+		}
+		elements = append(elements, readGoLayout(pattern[start:i], start)...)
+		elements = append(elements, patternElement{field: tok.field, token: tok.text, offset: i})
+		i += len(tok.text)
+		start = i
+	}
+	return append(elements, readGoLayout(pattern[start:], start)...)
+}
+
+// readLDMLPattern reads a date-fns pattern with the FormatTokens tokenizer,
+// or a Java DateTimeFormatter pattern, whose optional sections are dropped.
+func readLDMLPattern(pattern string, java bool) ([]patternElement, error) {
+	var tokens []patternToken
+	if java {
+		tokens = splitJavaPattern(pattern)
+	} else {
+		var err error
+		if tokens, err = tokenizePattern(pattern, true, true); err != nil {
+			return nil, err
+		}
+	}
+
+	var elements []patternElement
+	for _, tok := range tokens {
+		if tok.letter == 0 {
+			elements = appendLiteralElement(elements, tok.literal, tok.offset)
+			continue
+		}
+		text := tok.text()
+		if tok.aux > 0 {
+			text += strings.Repeat("p", tok.aux)
+		}
+		if tok.letter == 'P' || tok.letter == 'p' {
+			// Localized patterns are expanded with the en-US patterns.
+			expanded, err := readLDMLPattern(longFormatPattern(tok, LocaleEnUS), false)
+			if err != nil {
+				return nil, err
+			}
+			for _, el := range expanded {
+				if el.field == fieldLiteral {
+					elements = appendLiteralElement(elements, el.literal, tok.offset)
+					continue
+				}
+				el.token, el.offset = text, tok.offset
+				el.note = "the localized pattern is expanded with the en-US pattern"
+				elements = append(elements, el)
+			}
+			continue
+		}
+
+		el, err := ldmlElement(tok, java)
+		if err != nil {
+			return nil, &TokenError{Pattern: pattern, Token: text, Offset: tok.offset, Err: err}
+		}
+		el.token, el.offset = text, tok.offset
+		elements = append(elements, el)
+	}
+	return elements, nil
+}
+
+// splitJavaPattern splits a Java pattern into runs of letters and literals,
+// dropping the brackets of optional sections.
+func splitJavaPattern(pattern string) []patternToken {
+	var tokens []patternToken
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'':
+			start := i
+			var lit strings.Builder
+			for i++; i < len(pattern); i++ {
+				if pattern[i] == '\'' {
+					if i+1 < len(pattern) && pattern[i+1] == '\'' {
+						lit.WriteByte('\'')
+						i++
+						continue
+					}
+					i++
+					break
+				}
+				lit.WriteByte(pattern[i])
+			}
+			if i == start+2 && pattern[start+1] == '\'' {
+				lit.WriteByte('\'')
+			}
+			tokens = append(tokens, patternToken{literal: lit.String(), offset: start})
+		case c == '[' || c == ']':
+			i++
+		case isASCIILetter(c):
+			n := 1
+			for i+n < len(pattern) && pattern[i+n] == c {
+				n++
+			}
+			tokens = append(tokens, patternToken{letter: c, length: n, offset: i})
+			i += n
+		default:
+			tokens = append(tokens, patternToken{literal: pattern[i : i+1], offset: i})
+			i++
+		}
+	}
+	return tokens
+}
+
+// ldmlElement reads a date-fns or Java token. It returns ErrUnknownToken for
+// letters the dialect does not define and errors.ErrUnsupported for tokens
+// without a field in the other dialects.
+func ldmlElement(tok patternToken, java bool) (patternElement, error) {
+	n := tok.length
+	el := patternElement{}
+	pick := func(fields ...patternField) patternField {
+		return fields[min(n, len(fields))-1]
+	}
+
+	switch tok.letter {
+	case 'G':
+		el.field = fieldEra
+		if n > 3 {
+			el.note = "the long and narrow era names have no equivalent; the abbreviation is used"
+		}
+	case 'y', 'u':
+		el.field = fieldYear
+		if n == 2 {
+			el.field = fieldYearShort
+		}
+	case 'Y':
+		el.field = fieldWeekYear
+		if n == 2 {
+			el.field = fieldWeekYearShort
+		}
+		el.note = "Y is the week-numbering year, which differs from the calendar year y around New Year; use y for the calendar year"
+	case 'R':
+		if java {
+			return el, ErrUnknownToken
+		}
+		el.field = fieldISOWeekYear
+	case 'Q', 'q':
+		el.field = fieldQuarter
+		if n > 1 {
+			el.note = "padded quarters and quarter names have no equivalent; the quarter number is used"
+		}
+	case 'M', 'L':
+		el.field = pick(fieldMonth, fieldMonthPad, fieldMonthShort, fieldMonthLong, fieldMonthShort)
+		if n > 4 {
+			el.note = "narrow month names have no equivalent; the abbreviation is used"
+		}
+	case 'w':
+		el.field = pick(fieldWeek, fieldWeekPad)
+	case 'I':
+		if java {
+			return el, ErrUnknownToken
+		}
+		el.field = pick(fieldISOWeek, fieldISOWeekPad)
+	case 'd':
+		el.field = pick(fieldDay, fieldDayPad)
+	case 'D':
+		el.field = pick(fieldDayOfYear, fieldDayOfYearPad, fieldDayOfYearPad)
+		if n < 3 {
+			el.note = "D is the day of the year; use d for the day of the month"
+		}
+	case 'E':
+		el.field = pick(fieldWeekdayShort, fieldWeekdayShort, fieldWeekdayShort, fieldWeekdayLong, fieldWeekdayShort)
+		if n > 4 {
+			el.note = "narrow weekday names have no equivalent; the abbreviation is used"
+		}
+	case 'e', 'c':
+		el.field = pick(fieldWeekday, fieldWeekday, fieldWeekdayShort, fieldWeekdayLong, fieldWeekdayShort)
+		if n < 3 {
+			el.note = "the local weekday number depends on the first day of the week; the number from Sunday=0 is used"
+		}
+	case 'i':
+		if java {
+			return el, ErrUnknownToken
+		}
+		el.field = pick(fieldISOWeekday, fieldISOWeekday, fieldWeekdayShort, fieldWeekdayLong, fieldWeekdayShort)
+	case 'a':
+		el.field = fieldMeridiem
+		if !java && n >= 3 {
+			el.field = fieldMeridiemLower
+		}
+	case 'b', 'B':
+		if java && tok.letter == 'b' {
+			return el, ErrUnknownToken
+		}
+		el.field = fieldMeridiem
+		el.note = "day periods such as noon have no equivalent; AM/PM is used"
+	case 'h':
+		el.field = pick(fieldHour12, fieldHour12Pad)
+	case 'H':
+		el.field = pick(fieldHour24, fieldHour24Pad)
+	case 'K':
+		el.field = pick(fieldHour12, fieldHour12Pad)
+		el.note = "K counts hours from 0 to 11"
+	case 'k':
+		el.field = pick(fieldHour24, fieldHour24Pad)
+		el.note = "k counts hours from 1 to 24"
+	case 'm':
+		el.field = pick(fieldMinute, fieldMinutePad)
+	case 's':
+		el.field = pick(fieldSecond, fieldSecondPad)
+	case 'S':
+		el.field, el.digits = fieldFraction, min(n, 9)
+	case 'n':
+		if !java {
+			return el, ErrUnknownToken
+		}
+		el.field, el.digits = fieldFraction, 9
+		el.note = "Java writes nanoseconds without padding for n"
+	case 'X', 'x':
+		el.field = pick(fieldOffsetHours, fieldOffset, fieldOffsetColon, fieldOffset, fieldOffsetColon)
+		if tok.letter == 'X' {
+			el.field = pick(fieldOffsetHoursZ, fieldOffsetZ, fieldOffsetColonZ, fieldOffsetZ, fieldOffsetColonZ)
+		}
+		if n > 3 {
+			el.note = "the seconds of the offset are dropped"
+		}
+	case 'Z':
+		if !java {
+			return el, ErrUnknownToken
+		}
+		el.field = pick(fieldOffset, fieldOffset, fieldOffset, fieldOffsetColon, fieldOffsetColonZ)
+		if n == 4 {
+			el.note = "the GMT prefix of the localized offset is dropped"
+		}
+	case 'O':
+		el.field = fieldOffsetColon
+		el.note = "the GMT prefix of the localized offset is dropped"
+	case 'z':
+		el.field = fieldZone
+		if !java {
+			el.note = "FormatTokens and date-fns write z as a GMT offset (GMT-7), not a zone abbreviation"
+		}
+	case 'V', 'v':
+		if !java {
+			return el, ErrUnknownToken
+		}
+		el.field = fieldZone
+		el.note = "the zone identifier or generic name is written as a zone abbreviation"
+	case 't', 'T':
+		if java {
+			return el, ErrUnknownToken
+		}
+		el.field = fieldUnix
+		if tok.letter == 'T' {
+			el.field = fieldUnixMillis
+		}
+	case 'A', 'N', 'F', 'W', 'g':
+		if !java {
+			return el, ErrUnknownToken
+		}
+		return el, errors.ErrUnsupported
+	default:
+		return el, ErrUnknownToken
+	}
+
+	switch tok.modifier {
+	case 'o':
+		if tok.letter == 'd' {
+			el.field = fieldDayOrdinal
+		} else {
+			el.note = "the ordinal suffix is dropped"
+		}
+	case 'n', 'N':
+		el.note = "spelled-out numbers have no equivalent; digits are used"
+	}
+	return el, nil
+}
+
+// readMomentPattern reads a Moment.js pattern, where text in brackets is literal.
+func readMomentPattern(pattern string) []patternElement {
+	var elements []patternElement
+	for i := 0; i < len(pattern); {
+		if pattern[i] == '[' {
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				end = len(pattern) - i
+			}
+			elements = appendLiteralElement(elements, pattern[i+1:i+end], i)
+			i += end + 1
+			continue
+		}
+		if pattern[i] == 'S' {
+			n := 1
+			for i+n < len(pattern) && pattern[i+n] == 'S' {
+				n++
+			}
+			elements = append(elements, patternElement{field: fieldFraction, digits: min(n, 9), token: pattern[i : i+n], offset: i})
+			i += n
+			continue
+		}
+		if tok, ok := matchDialectToken(momentTokens, pattern, i); ok {
+			elements = append(elements, patternElement{field: tok.field, token: tok.text, offset: i, note: tok.note})
+			i += len(tok.text)
+			continue
+		}
+		elements = appendLiteralElement(elements, pattern[i:i+1], i)
+		i++
+	}
+	return elements
+}
+
+// readPHPPattern reads a PHP date() pattern, where a backslash escapes the
+// next character; base is added to the offsets.
+func readPHPPattern(pattern string, base int) ([]patternElement, error) {
+	var elements []patternElement
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			elements = appendLiteralElement(elements, pattern[i:i+1], base+i-1)
+			continue
+		case !isASCIILetter(c):
+			elements = appendLiteralElement(elements, pattern[i:i+1], base+i)
+			continue
+		case c == 'S':
+			// S adds the English ordinal suffix to the day before it.
+			if n := len(elements); n > 0 && (elements[n-1].field == fieldDay || elements[n-1].field == fieldDayPad) {
+				elements[n-1].field = fieldDayOrdinal
+				elements[n-1].token += "S"
+				continue
+			}
+		}
+		if composite, ok := phpComposites[c]; ok {
+			expanded, _ := readPHPPattern(composite, 0)
+			for _, el := range expanded {
+				el.token, el.offset = pattern[i:i+1], base+i
+				if el.field == fieldLiteral {
+					elements = appendLiteralElement(elements, el.literal, base+i)
+				} else {
+					elements = append(elements, el)
+				}
+			}
+			continue
+		}
+		tok, ok := matchDialectToken(phpTokens, pattern, i)
+		switch {
+		case ok:
+			elements = append(elements, patternElement{field: tok.field, token: tok.text, offset: base + i, note: tok.note})
+			i += len(tok.text) - 1
+		case strings.IndexByte("tLBIZS", c) >= 0:
+			return nil, fmt.Errorf("cannot convert %q at offset %d from PHP: %w", c, base+i, errors.ErrUnsupported)
+		default:
+			// PHP copies letters it does not define.
+			elements = appendLiteralElement(elements, pattern[i:i+1], base+i)
+		}
+	}
+	return elements, nil
+}
+
+// readStrftimePattern reads a strftime pattern; composite conversions are
+// expanded and reported at the offset of the composite in the original.
+func readStrftimePattern(original, pattern string, base int) ([]patternElement, error) {
+	var elements []patternElement
+	for i := 0; i < len(pattern); {
+		if pattern[i] != '%' {
+			elements = appendLiteralElement(elements, pattern[i:i+1], base+i)
+			i++
+			continue
+		}
+		d, next, err := scanStrftimeDirective(pattern, i)
+		if err != nil {
+			var tokenErr *TokenError
+			if errors.As(err, &tokenErr) {
+				tokenErr.Pattern, tokenErr.Offset = original, base+i
+			}
+			return nil, err
+		}
+		text, offset := pattern[i:next], base+i
+		i = next
+
+		if composite, ok := strftimeComposites[d.conv]; ok {
+			expanded, _ := readStrftimePattern(composite, composite, 0)
+			for _, el := range expanded {
+				if el.field == fieldLiteral {
+					elements = appendLiteralElement(elements, el.literal, offset)
+					continue
+				}
+				el.token, el.offset = text, offset
+				elements = append(elements, el)
+			}
+			continue
+		}
+		switch d.conv {
+		case 'n':
+			elements = appendLiteralElement(elements, "\n", offset)
+			continue
+		case 't':
+			elements = appendLiteralElement(elements, "\t", offset)
+			continue
+		case '%':
+			elements = appendLiteralElement(elements, "%", offset)
+			continue
+		}
+
+		el, ok := strftimeElement(d)
+		if !ok {
+			return nil, fmt.Errorf("cannot convert %q at offset %d from strftime: %w", text, offset, errors.ErrUnsupported)
+		}
+		el.token, el.offset = text, offset
+		if d.upper || d.swap {
+			el.note = "the case flags have no equivalent"
+		}
+		elements = append(elements, el)
+	}
+	return elements, nil
+}
+
+// strftimeElement reads a non-composite strftime conversion and its padding flag.
+func strftimeElement(d strftimeDirective) (patternElement, bool) {
+	var el patternElement
+	// pick chooses the field for the default, "0", "-" and "_" padding;
+	// fieldLiteral as space means the field has no space-padded form.
+	pick := func(def, pad, none, space patternField) patternField {
+		switch d.pad {
+		case '0':
+			return pad
+		case '-':
+			return none
+		case '_':
+			if space != fieldLiteral {
+				return space
+			}
+			el.note = "space padding has no equivalent; no padding is used"
+			return none
+		}
+		return def
+	}
+
+	switch d.conv {
+	case 'a':
+		el.field = fieldWeekdayShort
+	case 'A':
+		el.field = fieldWeekdayLong
+	case 'b', 'h':
+		el.field = fieldMonthShort
+	case 'B':
+		el.field = fieldMonthLong
+	case 'd':
+		el.field = pick(fieldDayPad, fieldDayPad, fieldDay, fieldDaySpace)
+	case 'e':
+		el.field = pick(fieldDaySpace, fieldDayPad, fieldDay, fieldDaySpace)
+	case 'G':
+		el.field = fieldISOWeekYear
+	case 'g':
+		el.field = fieldISOWeekYearShort
+	case 'H':
+		el.field = pick(fieldHour24Pad, fieldHour24Pad, fieldHour24, fieldLiteral)
+	case 'k':
+		el.field = pick(fieldHour24, fieldHour24Pad, fieldHour24, fieldLiteral)
+		if d.pad == 0 {
+			el.note = "space padding has no equivalent; no padding is used"
+		}
+	case 'I':
+		el.field = pick(fieldHour12Pad, fieldHour12Pad, fieldHour12, fieldLiteral)
+	case 'l':
+		el.field = pick(fieldHour12, fieldHour12Pad, fieldHour12, fieldLiteral)
+		if d.pad == 0 {
+			el.note = "space padding has no equivalent; no padding is used"
+		}
+	case 'j':
+		el.field = pick(fieldDayOfYearPad, fieldDayOfYearPad, fieldDayOfYear, fieldLiteral)
+	case 'm':
+		el.field = pick(fieldMonthPad, fieldMonthPad, fieldMonth, fieldLiteral)
+	case 'M':
+		el.field = pick(fieldMinutePad, fieldMinutePad, fieldMinute, fieldLiteral)
+	case 'S':
+		el.field = pick(fieldSecondPad, fieldSecondPad, fieldSecond, fieldLiteral)
+	case 'p':
+		el.field = fieldMeridiem
+	case 'P':
+		el.field = fieldMeridiemLower
+	case 's':
+		el.field = fieldUnix
+	case 'u':
+		el.field = fieldISOWeekday
+	case 'w':
+		el.field = fieldWeekday
+	case 'U', 'W':
+		el.field = pick(fieldWeekPad, fieldWeekPad, fieldWeek, fieldLiteral)
+		el.note = "strftime counts %U and %W weeks from the first Sunday or Monday, starting at 00; the locale week is used"
+	case 'V':
+		el.field = pick(fieldISOWeekPad, fieldISOWeekPad, fieldISOWeek, fieldLiteral)
+	case 'y':
+		el.field = fieldYearShort
+	case 'Y':
+		el.field = fieldYear
+	case 'z':
+		el.field = fieldOffset
+		if d.colons > 0 {
+			el.field = fieldOffsetColon
+		}
+		if d.colons > 1 {
+			el.note = "the seconds of the offset are dropped"
+		}
+	case 'Z':
+		el.field = fieldZone
+	case 'f':
+		el.field, el.digits = fieldFraction, 6
+	case 'N':
+		el.field, el.digits = fieldFraction, 9
+		if d.width > 0 && d.width < 9 {
+			el.digits = d.width
+		}
+	default:
+		return el, false
+	}
+	return el, true
+}
@@ -0,0 +1,183 @@
+package dateutils
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConvertPattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		from, to Dialect
+		want     string
+		warnings []string // Substrings of the expected warnings, in order
+	}{
+		{"Go to LDML", "2006-01-02 15:04:05.000 Z07:00", DialectGo, DialectLDML, "yyyy-MM-dd HH:mm:ss.SSS XXX", nil},
+		{"Go to Moment", "2006-01-02 15:04:05.000 -07:00", DialectGo, DialectMoment, "YYYY-MM-DD HH:mm:ss.SSS Z", nil},
+		{"Go to Java", "Monday, January 2, 2006 3:04 PM", DialectGo, DialectJava, "EEEE, MMMM d, yyyy h:mm a", nil},
+		{"Go to PHP", "2006-01-02T15:04:05.000000Z07:00", DialectGo, DialectPHP, `Y-m-d\TH:i:s.up`, nil},
+		{"Go to strftime", "Mon Jan _2 15:04:05 MST 2006", DialectGo, DialectStrftime, "%a %b %e %H:%M:%S %Z %Y", nil},
+		{"Go underscore before year", "_2006", DialectGo, DialectLDML, "_yyyy", nil},
+		{"Go trimmed fraction", "15:04:05.999", DialectGo, DialectMoment, "HH:mm:ss.SSS",
+			[]string{"trailing zeros are kept"}},
+		{"LDML to Go", "EEEE, MMMM do yyyy 'at' h:mm a", DialectLDML, DialectGo, "Monday, January 2 2006 at 3:04 PM",
+			[]string{"Go has no token for the ordinal day"}},
+		{"LDML to Moment", "EEEE, MMMM do yyyy 'at' h:mm a", DialectLDML, DialectMoment, "dddd, MMMM Do YYYY [at] h:mm A", nil},
+		{"LDML to PHP", "EEEE, MMMM do yyyy 'at' h:mm a", DialectLDML, DialectPHP, `l, F jS Y \a\t g:i A`, nil},
+		{"LDML to strftime", "dd/MM/yyyy HH:mm '100%'", DialectLDML, DialectStrftime, "%d/%m/%Y %H:%M 100%%", nil},
+		{"LDML week year and day of year", "YYYY-MM-DD", DialectLDML, DialectGo, "2006-01-002",
+			[]string{"week-numbering year", "day of the year", "Go has no token for the locale week-numbering year"}},
+		{"LDML week year to Moment", "YYYY-'W'ww", DialectLDML, DialectMoment, "gggg-[W]ww",
+			[]string{"week-numbering year"}},
+		{"LDML ISO week to strftime", "RRRR-'W'II-i", DialectLDML, DialectStrftime, "%G-W%V-%u", nil},
+		{"LDML localized pattern", "PPpp", DialectLDML, DialectGo, "Jan 2, 2006, 3:04:05 PM",
+			[]string{"expanded with the en-US pattern"}},
+		{"LDML quotes", "h 'o''clock'", DialectLDML, DialectMoment, "h [o'clock]", nil},
+		{"Moment to LDML", "dddd, MMMM Do YYYY, h:mm:ss a", DialectMoment, DialectLDML, "EEEE, MMMM do yyyy, h:mm:ss aaa", nil},
+		{"Moment to Go", "YYYY-MM-DDTHH:mm:ss.SSSZ", DialectMoment, DialectGo, "2006-01-02T15:04:05.000-07:00", nil},
+		{"Moment brackets to Java", "[Today is] dddd", DialectMoment, DialectJava, "'Today is' EEEE", nil},
+		{"Moment ISO week", "GGGG-[W]WW-E", DialectMoment, DialectLDML, "RRRR-'W'II-i", nil},
+		{"Java to Go", "uuuu-MM-dd'T'HH:mm:ss[.SSS]XXX", DialectJava, DialectGo, "2006-01-02T15:04:05.000Z07:00", nil},
+		{"Java to Moment", "dd MMM yyyy HH:mm:ss Z", DialectJava, DialectMoment, "DD MMM YYYY HH:mm:ss ZZ", nil},
+		{"Java escaped quote", "hh 'o''clock' a", DialectJava, DialectLDML, "hh 'o''clock' a", nil},
+		{"PHP to LDML", `l jS \o\f F Y h:i:s A`, DialectPHP, DialectLDML, "EEEE do 'of' MMMM yyyy hh:mm:ss a", nil},
+		{"PHP composite", "c", DialectPHP, DialectMoment, "YYYY-MM-DD[T]HH:mm:ssZ", nil},
+		{"PHP zero-based day of year", "z", DialectPHP, DialectLDML, "D", []string{"from 0", "UseAdditionalDayOfYearTokens"}},
+		{"strftime to Go", "%a %-d %b %Y %H:%M:%S %z", DialectStrftime, DialectGo, "Mon 2 Jan 2006 15:04:05 -0700", nil},
+		{"strftime composite", "%F %T", DialectStrftime, DialectLDML, "yyyy-MM-dd HH:mm:ss", nil},
+		{"strftime space padding", "%e %k", DialectStrftime, DialectLDML, "d H",
+			[]string{"space padding has no equivalent", "LDML has no token for the space-padded day"}},
+		{"strftime fraction", "%S.%3N", DialectStrftime, DialectGo, "05.000", nil},
+		{"FormatCustom to LDML", "YYYY-MM-DD hh:mm:ss AM/PM Mon", DialectCustom, DialectLDML, "yyyy-MM-dd hh:mm:ss a EEE", nil},
+		{"LDML to FormatCustom", "yyyy-MM-dd'T'HH:mm:ss MMMM", DialectLDML, DialectCustom, "YYYY-MM-DDTHH:mm:ss January", nil},
+		{"Go literal read as layout", "d 'de' MMMM", DialectLDML, DialectGo, "2 de January", nil},
+		{"Go cannot escape", "'Monday' yyyy", DialectLDML, DialectGo, "Monday 2006",
+			[]string{"Go cannot escape the literal \"Monday \""}},
+		{"Missing fraction separator", "ssSSS", DialectLDML, DialectGo, "05.000", []string{"a '.' is added"}},
+		{"Lossy PHP fraction", "ss.SS", DialectLDML, DialectPHP, "s.v", []string{"not 2"}},
+		{"Java lower-case meridiem", "h:mm a", DialectMoment, DialectJava, "h:mm a",
+			[]string{"Java has no token for the lower-case meridiem"}},
+		{"Same dialect", "yyyy-MM-dd", DialectLDML, DialectLDML, "yyyy-MM-dd", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, warnings, err := ConvertPattern(tt.pattern, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("ConvertPattern() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ConvertPattern(%q, %s, %s) = %q, want %q", tt.pattern, tt.from, tt.to, got, tt.want)
+			}
+			if len(warnings) != len(tt.warnings) {
+				t.Fatalf("ConvertPattern(%q) warnings = %v, want %d", tt.pattern, warnings, len(tt.warnings))
+			}
+			for i, want := range tt.warnings {
+				if !strings.Contains(warnings[i].Message, want) {
+					t.Errorf("warning %d = %q, want it to contain %q", i, warnings[i].Message, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertPatternWarningPosition(t *testing.T) {
+	_, warnings, err := ConvertPattern("dd/MM/YYYY", DialectLDML, DialectMoment)
+	if err != nil {
+		t.Fatalf("ConvertPattern() error = %v", err)
+	}
+	if len(warnings) != 1 || warnings[0].Token != "YYYY" || warnings[0].Offset != 6 {
+		t.Fatalf("warnings = %v, want one for \"YYYY\" at offset 6", warnings)
+	}
+	if got := warnings[0].String(); !strings.HasPrefix(got, `"YYYY" at offset 6: `) {
+		t.Errorf("Warning.String() = %q", got)
+	}
+}
+
+func TestConvertPatternFormatsAlike(t *testing.T) {
+	date := time.Date(2024, time.March, 5, 14, 5, 7, 123000000, time.FixedZone("", -3*3600))
+	patterns := []string{
+		"yyyy-MM-dd'T'HH:mm:ss.SSSXXX",
+		"EEEE, MMMM d, yyyy h:mm a",
+		"EEE dd MMM yy hh:mm:ss xx",
+		"d/M/yyyy H:m:s",
+	}
+	for _, pattern := range patterns {
+		want, _ := FormatTokens(date, pattern, nil)
+
+		layout, _, err := ConvertPattern(pattern, DialectLDML, DialectGo)
+		if err != nil {
+			t.Fatalf("ConvertPattern(%q, Go) error = %v", pattern, err)
+		}
+		if got := date.Format(layout); got != want {
+			t.Errorf("Go layout %q = %q, FormatTokens = %q", layout, got, want)
+		}
+
+		strftime, _, err := ConvertPattern(pattern, DialectLDML, DialectStrftime)
+		if err != nil {
+			t.Fatalf("ConvertPattern(%q, strftime) error = %v", pattern, err)
+		}
+		if got, _ := Strftime(date, strftime); got != want {
+			t.Errorf("strftime pattern %q = %q, FormatTokens = %q", strftime, got, want)
+		}
+
+		back, _, err := ConvertPattern(layout, DialectGo, DialectLDML)
+		if err != nil {
+			t.Fatalf("ConvertPattern(%q, LDML) error = %v", layout, err)
+		}
+		if got, _ := FormatTokens(date, back, nil); got != want {
+			t.Errorf("round trip %q = %q, want %q", back, got, want)
+		}
+	}
+}
+
+func TestConvertPatternErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		from, to Dialect
+		check    func(error) bool
+	}{
+		{"Empty pattern", "", DialectGo, DialectLDML, func(err error) bool { return errors.Is(err, ErrEmptyFormat) }},
+		{"Unknown LDML letter", "yyyy-MM-dd f", DialectLDML, DialectGo, func(err error) bool {
+			var tokenErr *TokenError
+			return errors.As(err, &tokenErr) && tokenErr.Token == "f" && errors.Is(err, ErrUnknownToken)
+		}},
+		{"Unknown Java letter", "yyyy 'W'I", DialectJava, DialectGo, func(err error) bool { return errors.Is(err, ErrUnknownToken) }},
+		{"Unknown strftime conversion", "%Y %Q", DialectStrftime, DialectGo, func(err error) bool {
+			var tokenErr *TokenError
+			return errors.As(err, &tokenErr) && tokenErr.Offset == 3
+		}},
+		{"Unix timestamp in Go", "t", DialectLDML, DialectGo, func(err error) bool { return errors.Is(err, errors.ErrUnsupported) }},
+		{"Quarter in Java from PHP", "L", DialectPHP, DialectJava, func(err error) bool { return errors.Is(err, errors.ErrUnsupported) }},
+		{"Century from strftime", "%C", DialectStrftime, DialectLDML, func(err error) bool { return errors.Is(err, errors.ErrUnsupported) }},
+		{"Unknown source dialect", "yyyy", Dialect(0), DialectGo, func(err error) bool { return err != nil }},
+		{"Unknown target dialect", "yyyy", DialectLDML, Dialect(99), func(err error) bool { return err != nil }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ConvertPattern(tt.pattern, tt.from, tt.to)
+			if !tt.check(err) {
+				t.Errorf("ConvertPattern(%q, %s, %s) error = %v", tt.pattern, tt.from, tt.to, err)
+			}
+		})
+	}
+}
+
+func TestDialectString(t *testing.T) {
+	if got := DialectMoment.String(); got != "Moment" {
+		t.Errorf("DialectMoment.String() = %q", got)
+	}
+	if got := Dialect(42).String(); got != "Dialect(42)" {
+		t.Errorf("Dialect(42).String() = %q", got)
+	}
+}
+
+func BenchmarkConvertPattern(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _, _ = ConvertPattern("EEEE, MMMM do yyyy 'at' h:mm:ss.SSS a XXX", DialectLDML, DialectMoment)
+	}
+}
@@ -0,0 +1,226 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateMathLayout is the layout name reported in EvalDateMath errors.
+const dateMathLayout = "date math"
+
+// DateMathOptions configures EvalDateMath.
+type DateMathOptions struct {
+	// RoundUp makes /unit round to the last instant of the unit with the
+	// EndOfX functions, instead of the first one with StartOfX. Use it for
+	// the end of a range, like Elasticsearch lte bounds and Grafana "to" times.
+	RoundUp bool
+}
+
+// EvalDateMath evaluates an Elasticsearch or Grafana date-math expression.
+// The expression starts with an anchor, either "now" or a date followed by
+// "||", and continues with any number of operations applied left to right:
+//   - +N unit and -N unit add or subtract N units, where N defaults to 1;
+//   - /unit rounds down to the start of the unit, or up to its end with
+//     opts.RoundUp.
+//
+// The units are y (years), M (months), w (weeks), d (days), h or H (hours),
+// m (minutes) and s (seconds), and are case-sensitive. Weeks start on Monday,
+// as with StartOfWeek. Months and years are added as with AddMonths and
+// AddYears, clamping to the end of shorter months.
+//
+// Anchor dates are ISO 8601 dates or epoch milliseconds; a date without an
+// offset is a wall-clock time in loc. now is converted to loc too, so
+// rounding to days follows loc's midnight. Nil loc means UTC. A date with
+// no "||" and no operations is also accepted.
+// Returns a *ParseError if the expression is invalid, or an error wrapping
+// ErrEmptyInput for an empty one.
+//
+// Example:
+//
+//	now := time.Date(2024, 3, 6, 14, 30, 0, 0, time.UTC)
+//	EvalDateMath("now-7d/d", now, nil, nil)          // 2024-02-28 00:00:00
+//	EvalDateMath("now/M+1M", now, nil, nil)          // 2024-04-01 00:00:00
+//	EvalDateMath("2024-01-31||+1M/d", now, nil, nil) // 2024-02-29 00:00:00
+//	EvalDateMath("now/M", now, nil, &DateMathOptions{RoundUp: true})
+//	// 2024-03-31 23:59:59.999999999
+func EvalDateMath(expr string, now time.Time, loc *time.Location, opts *DateMathOptions) (time.Time, error) {
+	if expr == "" {
+		return time.Time{}, fmt.Errorf("cannot evaluate date math: %w", ErrEmptyInput)
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	roundUp := opts != nil && opts.RoundUp
+	fail := func(offset int, err error) (time.Time, error) {
+		return time.Time{}, newParseError(expr, []LayoutAttempt{{Layout: dateMathLayout, Offset: offset, Err: err}})
+	}
+
+	var t time.Time
+	i := 0
+	if strings.HasPrefix(expr, "now") {
+		t = now.In(loc)
+		i = len("now")
+	} else {
+		end := strings.Index(expr, "||")
+		if end < 0 {
+			end = len(expr)
+			i = end
+		} else {
+			i = end + len("||")
+		}
+		anchor, err := parseDateMathAnchor(expr[:end], loc)
+		if err != nil {
+			return fail(0, fmt.Errorf(`expected "now" or a date followed by "||": %w`, err))
+		}
+		t = anchor
+	}
+
+	for i < len(expr) {
+		op := expr[i]
+		if op != '+' && op != '-' && op != '/' {
+			return fail(i, errors.New(`expected "+", "-" or "/"`))
+		}
+		i++
+		start := i
+		for i < len(expr) && isDigit(expr[i]) {
+			i++
+		}
+		n := 1
+		if i > start {
+			if op == '/' {
+				return fail(start, errors.New("rounding takes a unit without a number"))
+			}
+			value, err := strconv.Atoi(expr[start:i])
+			if err != nil || i-start > 9 {
+				return fail(start, errors.New("number is too large"))
+			}
+			n = value
+		}
+		if i == len(expr) || strings.IndexByte("yMwdhHms", expr[i]) < 0 {
+			return fail(i, errors.New("expected a unit: y, M, w, d, h, H, m or s"))
+		}
+		unit := expr[i]
+		i++
+		switch op {
+		case '+':
+			t = addDateMath(t, unit, n)
+		case '-':
+			t = addDateMath(t, unit, -n)
+		default:
+			t = roundDateMath(t, unit, roundUp)
+		}
+	}
+	return t, nil
+}
+
+// ParseTimeRange evaluates the two ends of a Grafana-style time range, such
+// as "now-24h" to "now" or "now-1d/d" to "now-1d/d" for yesterday, and
+// returns them as an Interval. The end is rounded up, so "now/w" to "now/w"
+// is the whole current week. Both ends use EvalDateMath with now and loc.
+// Returns the EvalDateMath error of either end, or an error wrapping
+// ErrInvalidInterval if the range ends before it starts.
+//
+// Example:
+//
+//	ParseTimeRange("now-1d/d", "now-1d/d", now, nil) // yesterday, 00:00 to 23:59:59.999999999
+func ParseTimeRange(from, to string, now time.Time, loc *time.Location) (Interval, error) {
+	start, err := EvalDateMath(from, now, loc, nil)
+	if err != nil {
+		return Interval{}, fmt.Errorf("cannot parse range start: %w", err)
+	}
+	end, err := EvalDateMath(to, now, loc, &DateMathOptions{RoundUp: true})
+	if err != nil {
+		return Interval{}, fmt.Errorf("cannot parse range end: %w", err)
+	}
+	if end.Before(start) {
+		return Interval{}, fmt.Errorf("cannot parse range %q to %q: %w", from, to, ErrInvalidInterval)
+	}
+	return Interval{Start: start, End: end}, nil
+}
+
+// parseDateMathAnchor parses an anchor date: epoch milliseconds or ISO 8601,
+// read as a wall-clock time in loc when it has no offset.
+func parseDateMathAnchor(s string, loc *time.Location) (time.Time, error) {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }) < 0 {
+		millis, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, errors.New("epoch milliseconds out of range")
+		}
+		return time.UnixMilli(millis).In(loc), nil
+	}
+	result, err := ParseISODetailed(s, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if result.HasOffset {
+		return result.Time.In(loc), nil
+	}
+	t := result.Time
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc), nil
+}
+
+// addDateMath adds n of a date-math unit to t.
+func addDateMath(t time.Time, unit byte, n int) time.Time {
+	switch unit {
+	case 'y':
+		return AddYears(t, n)
+	case 'M':
+		return AddMonths(t, n)
+	case 'w':
+		return AddWeeks(t, n)
+	case 'd':
+		return AddDays(t, n)
+	case 'h', 'H':
+		return AddHours(t, n)
+	case 'm':
+		return AddMinutes(t, n)
+	default:
+		return AddSeconds(t, n)
+	}
+}
+
+// roundDateMath rounds t to the start, or with up to the end, of a
+// date-math unit.
+func roundDateMath(t time.Time, unit byte, up bool) time.Time {
+	switch unit {
+	case 'y':
+		if up {
+			return EndOfYear(t)
+		}
+		return StartOfYear(t)
+	case 'M':
+		if up {
+			return EndOfMonth(t)
+		}
+		return StartOfMonth(t)
+	case 'w':
+		if up {
+			return EndOfWeek(t)
+		}
+		return StartOfWeek(t)
+	case 'd':
+		if up {
+			return EndOfDay(t)
+		}
+		return StartOfDay(t)
+	case 'h', 'H':
+		if up {
+			return EndOfHour(t)
+		}
+		return StartOfHour(t)
+	case 'm':
+		if up {
+			return EndOfMinute(t)
+		}
+		return StartOfMinute(t)
+	default:
+		second := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+		if up {
+			return second.Add(time.Second - time.Nanosecond)
+		}
+		return second
+	}
+}
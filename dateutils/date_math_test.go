@@ -0,0 +1,151 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// dateMathNow is Wednesday, March 6, 2024, 14:30:15.5 UTC.
+var dateMathNow = time.Date(2024, time.March, 6, 14, 30, 15, 500000000, time.UTC)
+
+func TestEvalDateMath(t *testing.T) {
+	utc := func(year int, month time.Month, day, hour, minute, second, nanos int) time.Time {
+		return time.Date(year, month, day, hour, minute, second, nanos, time.UTC)
+	}
+
+	tests := []struct {
+		expr    string
+		roundUp bool
+		want    time.Time
+	}{
+		{"now", false, dateMathNow},
+		{"now-7d/d", false, utc(2024, 2, 28, 0, 0, 0, 0)},
+		{"now/M+1M", false, utc(2024, 4, 1, 0, 0, 0, 0)},
+		{"now-24h", false, utc(2024, 3, 5, 14, 30, 15, 500000000)},
+		{"now+1H", false, utc(2024, 3, 6, 15, 30, 15, 500000000)},
+		{"now-15m", false, utc(2024, 3, 6, 14, 15, 15, 500000000)},
+		{"now+30s", false, utc(2024, 3, 6, 14, 30, 45, 500000000)},
+		{"now-1y", false, utc(2023, 3, 6, 14, 30, 15, 500000000)},
+		{"now+2w", false, utc(2024, 3, 20, 14, 30, 15, 500000000)},
+		{"now+d", false, utc(2024, 3, 7, 14, 30, 15, 500000000)},
+		{"now/y", false, utc(2024, 1, 1, 0, 0, 0, 0)},
+		{"now/w", false, utc(2024, 3, 4, 0, 0, 0, 0)},
+		{"now/h", false, utc(2024, 3, 6, 14, 0, 0, 0)},
+		{"now/m", false, utc(2024, 3, 6, 14, 30, 0, 0)},
+		{"now/s", false, utc(2024, 3, 6, 14, 30, 15, 0)},
+		{"now/d", true, utc(2024, 3, 6, 23, 59, 59, 999999999)},
+		{"now/M", true, utc(2024, 3, 31, 23, 59, 59, 999999999)},
+		{"now/w", true, utc(2024, 3, 10, 23, 59, 59, 999999999)},
+		{"now/y", true, utc(2024, 12, 31, 23, 59, 59, 999999999)},
+		{"now/s", true, utc(2024, 3, 6, 14, 30, 15, 999999999)},
+		{"now-1d/d", true, utc(2024, 3, 5, 23, 59, 59, 999999999)},
+		{"2024-01-31||+1M/d", false, utc(2024, 2, 29, 0, 0, 0, 0)},
+		{"2024-01-31T10:00:00Z||-1d", false, utc(2024, 1, 30, 10, 0, 0, 0)},
+		{"2024-01-31T10:00:00+02:00||/h", false, utc(2024, 1, 31, 8, 0, 0, 0)},
+		{"1709735415000||/d", false, utc(2024, 3, 6, 0, 0, 0, 0)},
+		{"2024-03-01", false, utc(2024, 3, 1, 0, 0, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := EvalDateMath(tt.expr, dateMathNow, nil, &DateMathOptions{RoundUp: tt.roundUp})
+			if err != nil {
+				t.Fatalf("EvalDateMath(%q) error = %v", tt.expr, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("EvalDateMath(%q, RoundUp: %v) = %v, want %v", tt.expr, tt.roundUp, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvalDateMathLocation(t *testing.T) {
+	saoPaulo := time.FixedZone("BRT", -3*3600)
+
+	// 14:30 UTC is 11:30 in São Paulo, so the day starts at 03:00 UTC.
+	got, err := EvalDateMath("now/d", dateMathNow, saoPaulo, nil)
+	if err != nil {
+		t.Fatalf("EvalDateMath() error = %v", err)
+	}
+	if want := time.Date(2024, 3, 6, 0, 0, 0, 0, saoPaulo); !got.Equal(want) || got.Location() != saoPaulo {
+		t.Errorf("EvalDateMath(now/d) = %v, want %v", got, want)
+	}
+
+	got, err = EvalDateMath("2024-03-01T09:00||+1d", dateMathNow, saoPaulo, nil)
+	if err != nil {
+		t.Fatalf("EvalDateMath() error = %v", err)
+	}
+	if want := time.Date(2024, 3, 2, 9, 0, 0, 0, saoPaulo); !got.Equal(want) {
+		t.Errorf("EvalDateMath(date without offset) = %v, want %v", got, want)
+	}
+}
+
+func TestEvalDateMathErrors(t *testing.T) {
+	tests := []struct {
+		expr   string
+		offset int
+	}{
+		{"now-7x", 5},
+		{"now-7", 5},
+		{"now/2d", 4},
+		{"now*2d", 3},
+		{"now-7D", 5},
+		{"now-9999999999d", 4},
+		{"yesterday", 0},
+		{"2024-13-01||+1d", 0},
+		{"2024-01-01||+1d+", 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			var parseErr *ParseError
+			_, err := EvalDateMath(tt.expr, dateMathNow, nil, nil)
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("EvalDateMath(%q) error = %v, want a *ParseError", tt.expr, err)
+			}
+			if parseErr.Offset != tt.offset {
+				t.Errorf("EvalDateMath(%q) offset = %d, want %d", tt.expr, parseErr.Offset, tt.offset)
+			}
+		})
+	}
+
+	if _, err := EvalDateMath("", dateMathNow, nil, nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("EvalDateMath(\"\") error = %v, want ErrEmptyInput", err)
+	}
+}
+
+func TestParseTimeRange(t *testing.T) {
+	tests := []struct {
+		from, to   string
+		start, end time.Time
+	}{
+		{"now-24h", "now", time.Date(2024, 3, 5, 14, 30, 15, 500000000, time.UTC), dateMathNow},
+		{"now-1d/d", "now-1d/d", time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 5, 23, 59, 59, 999999999, time.UTC)},
+		{"now/w", "now/w", time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 10, 23, 59, 59, 999999999, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			got, err := ParseTimeRange(tt.from, tt.to, dateMathNow, nil)
+			if err != nil {
+				t.Fatalf("ParseTimeRange() error = %v", err)
+			}
+			if !got.Start.Equal(tt.start) || !got.End.Equal(tt.end) {
+				t.Errorf("ParseTimeRange() = %v to %v, want %v to %v", got.Start, got.End, tt.start, tt.end)
+			}
+		})
+	}
+
+	if _, err := ParseTimeRange("now", "now-1h", dateMathNow, nil); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("ParseTimeRange(reversed) error = %v, want ErrInvalidInterval", err)
+	}
+	var parseErr *ParseError
+	if _, err := ParseTimeRange("now", "now+1x", dateMathNow, nil); !errors.As(err, &parseErr) {
+		t.Errorf("ParseTimeRange(invalid end) error = %v, want a *ParseError", err)
+	}
+}
+
+func BenchmarkEvalDateMath(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = EvalDateMath("now-7d/d+12h", dateMathNow, nil, nil)
+	}
+}
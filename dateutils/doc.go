@@ -12,10 +12,12 @@
 //
 // # Function Categories
 //
-// Parsing: [Parse], [ParseISO], [ParseWithFormat]
-// Formatting: [Format], [FormatCustom], [FormatSafe], [FormatDistance]
+// Parsing: [Parse], [ParseISO], [ParseISODetailed], [ParseWithFormat], [ParseTokens], [IsMatch], [NewParser], [InferLayout], [ParseISOInterval], [ParseISORepeatingInterval], [ParseIntervalText], [Strptime], [ParseRFC2822], [ParseHTTPDate], [ParseRetryAfter], [ParseLogTimestamp], [ParseNatural], [EvalDateMath], [ParseTimeRange]
+// Formatting: [Format], [FormatCustom], [FormatTokens], [CompileLightFormat], [FormatSafe], [FormatDistance], [FormatRelative], [FormatInterval], [FormatOrdinal], [FormatSpelledOut], [Strftime], [ConvertPattern], [FormatRFC2822], [FormatHTTPDate]
+// Localization: [Locale], [LookupLocale], [FormatLocale], [FormatStyle], [ParserOptions], [SpellNumber], [NumberingSystem], [NormalizeDigits], [NaturalGrammar]
 // Comparison: [IsBefore], [IsAfter], [IsEqual], [IsSameDay], [IsSameWeek]
 // Manipulation: [AddDays], [AddHours], [AddMonths], [SubDays]
+// Durations: [Period], [ParsePeriod], [FormatISODuration], [AddPeriod], [IntervalToPeriod], [FormatDuration], [FormatTimeDuration]
 // Differences: [DifferenceInDays], [DifferenceInHours], [DifferenceInBusinessDays]
 // Validation: [IsValid], [IsLeapYear], [IsWeekend], [IsWithinInterval]
 // Period Utils: [StartOfDay], [EndOfDay], [StartOfWeek], [StartOfMonth]
@@ -0,0 +1,172 @@
+package dateutils
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sentinel errors wrapped by the formatting and parsing functions.
+// Use errors.Is to check for them.
+var (
+	// ErrEmptyInput is wrapped when the string to parse is empty.
+	ErrEmptyInput = errors.New("empty input")
+
+	// ErrZeroTime is wrapped when the time to format is the zero time.
+	ErrZeroTime = errors.New("zero time")
+
+	// ErrEmptyFormat is wrapped when the layout or pattern is empty.
+	ErrEmptyFormat = errors.New("empty format string")
+
+	// ErrAmbiguousDate is wrapped when an input matches several layouts
+	// with different results, such as "03/04/2024".
+	ErrAmbiguousDate = errors.New("ambiguous date")
+
+	// ErrInvalidInterval is wrapped when an interval ends before it starts.
+	ErrInvalidInterval = errors.New("interval ends before it starts")
+)
+
+// LayoutAttempt records why a single layout or pattern failed to match an input.
+type LayoutAttempt struct {
+	Layout string // The layout or pattern that was tried
+	Offset int    // Byte offset in the input where this layout stopped matching
+	Err    error  // The failure reported for this layout
+}
+
+// ParseError is returned by the parsing functions when an input cannot be parsed.
+// Unlike a plain error it keeps every layout that was tried, so callers can
+// report exactly which value failed and why.
+//
+// Example:
+//
+//	_, err := Parse("2024-13-01", nil)
+//	var parseErr *ParseError
+//	if errors.As(err, &parseErr) {
+//		fmt.Println(parseErr.Offset, parseErr.Suggestion) // 7 2006-01-02
+//	}
+type ParseError struct {
+	Input      string          // The input that could not be parsed
+	Offset     int             // Furthest byte offset reached by any layout
+	Attempts   []LayoutAttempt // Every layout that was tried, in order
+	Suggestion string          // Layout the input most closely resembles, if any
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	b.WriteString("unable to parse ")
+	b.WriteString(strconv.Quote(e.Input))
+
+	if len(e.Attempts) == 1 {
+		b.WriteString(" with layout ")
+		b.WriteString(strconv.Quote(e.Attempts[0].Layout))
+		if e.Attempts[0].Err != nil {
+			b.WriteString(": ")
+			b.WriteString(e.Attempts[0].Err.Error())
+		}
+		return b.String()
+	}
+
+	b.WriteString(": no layout matched (tried ")
+	b.WriteString(strconv.Itoa(len(e.Attempts)))
+	b.WriteString(")")
+	if e.Suggestion != "" {
+		b.WriteString("; closest layout ")
+		b.WriteString(strconv.Quote(e.Suggestion))
+		b.WriteString(" failed at offset ")
+		b.WriteString(strconv.Itoa(e.Offset))
+	}
+	return b.String()
+}
+
+// newParseError builds a ParseError from the attempts made, computing the
+// furthest offset and the suggested layout. A layout is suggested when it
+// matched at least half of the input before failing; ties go to the layout
+// whose length is closest to the input's.
+func newParseError(input string, attempts []LayoutAttempt) *ParseError {
+	err := &ParseError{Input: input, Attempts: attempts}
+
+	best := -1
+	for i, attempt := range attempts {
+		switch {
+		case best < 0 || attempt.Offset > err.Offset:
+		case attempt.Offset == err.Offset &&
+			absInt(len(attempt.Layout)-len(input)) < absInt(len(attempts[best].Layout)-len(input)):
+		default:
+			continue
+		}
+		err.Offset = attempt.Offset
+		best = i
+	}
+	if best >= 0 && err.Offset > 0 && err.Offset*2 >= len(input) {
+		err.Suggestion = attempts[best].Layout
+	}
+
+	return err
+}
+
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// layoutAttempt wraps an error returned by time.Parse into a LayoutAttempt,
+// deriving the failure offset from the unparsed remainder of the input.
+func layoutAttempt(layout, input string, err error) LayoutAttempt {
+	attempt := LayoutAttempt{Layout: layout, Err: err}
+
+	var timeErr *time.ParseError
+	if errors.As(err, &timeErr) && len(timeErr.ValueElem) <= len(input) {
+		attempt.Offset = len(input) - len(timeErr.ValueElem)
+	}
+
+	return attempt
+}
+
+// DateCandidate is one reading of an ambiguous input.
+type DateCandidate struct {
+	Layout string    // The layout that matched
+	Time   time.Time // The result of parsing the input with Layout
+}
+
+// AmbiguousDateError is returned by a Parser with RejectAmbiguous set when an
+// input matches several layouts with different results. It wraps ErrAmbiguousDate.
+//
+// Example:
+//
+//	parser := NewParser(&ParserOptions{RejectAmbiguous: true})
+//	_, err := parser.Parse("03/04/2024")
+//	var ambiguous *AmbiguousDateError
+//	if errors.As(err, &ambiguous) {
+//		fmt.Println(len(ambiguous.Candidates)) // 2
+//	}
+type AmbiguousDateError struct {
+	Input      string          // The input that was parsed
+	Candidates []DateCandidate // Every layout that matched, in the order tried
+}
+
+// Error implements the error interface.
+func (e *AmbiguousDateError) Error() string {
+	var b strings.Builder
+	b.WriteString("ambiguous date ")
+	b.WriteString(strconv.Quote(e.Input))
+	b.WriteString(": matches ")
+	for i, candidate := range e.Candidates {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.Quote(candidate.Layout))
+		b.WriteString(" (")
+		b.WriteString(candidate.Time.Format(time.RFC3339))
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// Unwrap returns ErrAmbiguousDate.
+func (e *AmbiguousDateError) Unwrap() error {
+	return ErrAmbiguousDate
+}
@@ -0,0 +1,129 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	date := time.Date(2024, time.March, 21, 0, 0, 0, 0, time.UTC)
+	ref := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		call    func() error
+		wantErr error
+	}{
+		{name: "Format zero time", call: func() error { _, err := Format(time.Time{}, DateISO, nil); return err }, wantErr: ErrZeroTime},
+		{name: "Format empty format", call: func() error { _, err := Format(date, "", nil); return err }, wantErr: ErrEmptyFormat},
+		{name: "FormatCustom zero time", call: func() error { _, err := FormatCustom(time.Time{}, "YYYY", nil); return err }, wantErr: ErrZeroTime},
+		{name: "FormatCustom empty format", call: func() error { _, err := FormatCustom(date, "", nil); return err }, wantErr: ErrEmptyFormat},
+		{name: "FormatTokens zero time", call: func() error { _, err := FormatTokens(time.Time{}, "yyyy", nil); return err }, wantErr: ErrZeroTime},
+		{name: "Parse empty input", call: func() error { _, err := Parse("", nil); return err }, wantErr: ErrEmptyInput},
+		{name: "ParseISO empty input", call: func() error { _, err := ParseISO("", nil); return err }, wantErr: ErrEmptyInput},
+		{name: "ParseWithFormat empty input", call: func() error { _, err := ParseWithFormat("", DateISO, nil); return err }, wantErr: ErrEmptyInput},
+		{name: "ParseWithFormat empty format", call: func() error { _, err := ParseWithFormat("2024-01-01", "", nil); return err }, wantErr: ErrEmptyFormat},
+		{name: "ParseTokens empty input", call: func() error { _, err := ParseTokens("", "yyyy", ref, nil); return err }, wantErr: ErrEmptyInput},
+		{name: "ParseTokens empty pattern", call: func() error { _, err := ParseTokens("2024", "", ref, nil); return err }, wantErr: ErrEmptyFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want wrapped %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name           string
+		parse          func() error
+		input          string
+		wantAttempts   int
+		wantOffset     int
+		wantSuggestion string
+	}{
+		{
+			name:           "Parse month out of range",
+			parse:          func() error { _, err := Parse("2024-13-01", nil); return err },
+			input:          "2024-13-01",
+			wantAttempts:   len(CommonDateFormats),
+			wantOffset:     7,
+			wantSuggestion: "2006-01-02",
+		},
+		{
+			name:           "Parse extra text",
+			parse:          func() error { _, err := Parse("2024-01-01 garbage", nil); return err },
+			input:          "2024-01-01 garbage",
+			wantAttempts:   len(CommonDateFormats),
+			wantOffset:     11,
+			wantSuggestion: "2006-01-02 15:04:05",
+		},
+		{
+			name:         "Parse unrelated input",
+			parse:        func() error { _, err := Parse("not-a-date", nil); return err },
+			input:        "not-a-date",
+			wantAttempts: len(CommonDateFormats),
+			wantOffset:   0,
+		},
+		{
+			name:           "ParseWithFormat",
+			parse:          func() error { _, err := ParseWithFormat("2024-01-01T10", DateISO, nil); return err },
+			input:          "2024-01-01T10",
+			wantAttempts:   1,
+			wantOffset:     10,
+			wantSuggestion: DateISO,
+		},
+		{
+			name:         "ParseISO",
+			parse:        func() error { _, err := ParseISO("25-12-2023", nil); return err },
+			input:        "25-12-2023",
+			wantAttempts: 1,
+			wantOffset:   0,
+		},
+		{
+			name: "ParseTokens",
+			parse: func() error {
+				_, err := ParseTokens("2024-03-x", "yyyy-MM-dd", time.Now(), nil)
+				return err
+			},
+			input:          "2024-03-x",
+			wantAttempts:   1,
+			wantOffset:     8,
+			wantSuggestion: "yyyy-MM-dd",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.parse()
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("error = %v (%T), want *ParseError", err, err)
+			}
+			if parseErr.Input != tt.input {
+				t.Errorf("Input = %q, want %q", parseErr.Input, tt.input)
+			}
+			if len(parseErr.Attempts) != tt.wantAttempts {
+				t.Errorf("len(Attempts) = %d, want %d", len(parseErr.Attempts), tt.wantAttempts)
+			}
+			if parseErr.Offset != tt.wantOffset {
+				t.Errorf("Offset = %d, want %d", parseErr.Offset, tt.wantOffset)
+			}
+			if parseErr.Suggestion != tt.wantSuggestion {
+				t.Errorf("Suggestion = %q, want %q", parseErr.Suggestion, tt.wantSuggestion)
+			}
+			for _, attempt := range parseErr.Attempts {
+				if attempt.Err == nil {
+					t.Errorf("attempt %q has no error", attempt.Layout)
+				}
+			}
+			if parseErr.Error() == "" {
+				t.Error("Error() returned an empty message")
+			}
+		})
+	}
+}
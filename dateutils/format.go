@@ -1,7 +1,7 @@
 package dateutils
 
 import (
-	"errors"
+	"fmt"
 	"strings"
 	"time"
 )
@@ -38,13 +38,14 @@ const (
 // Format formats a time.Time to string using the specified format.
 // The format parameter uses Go's reference time layout: Mon Jan 2 15:04:05 MST 2006.
 // If timezone is provided, the time will be converted to that timezone before formatting.
-// Returns an error if the time is zero value.
+// Returns an error wrapping ErrZeroTime if the time is zero value,
+// or ErrEmptyFormat if the format is empty.
 func Format(t time.Time, format string, timezone *time.Location) (string, error) {
 	if t.IsZero() {
-		return "", errors.New("cannot format zero time")
+		return "", fmt.Errorf("cannot format: %w", ErrZeroTime)
 	}
 	if format == "" {
-		return "", errors.New("format string cannot be empty")
+		return "", fmt.Errorf("cannot format: %w", ErrEmptyFormat)
 	}
 
 	// Convert to specified timezone if provided
@@ -88,10 +89,10 @@ func FormatWithDefault(t time.Time, format string, timezone *time.Location, defa
 // - AM/PM -> PM (meridiem)
 func FormatCustom(t time.Time, customFormat string, timezone *time.Location) (string, error) {
 	if t.IsZero() {
-		return "", errors.New("cannot format zero time")
+		return "", fmt.Errorf("cannot format: %w", ErrZeroTime)
 	}
 	if customFormat == "" {
-		return "", errors.New("format string cannot be empty")
+		return "", fmt.Errorf("cannot format: %w", ErrEmptyFormat)
 	}
 
 	// Convert to specified timezone if provided
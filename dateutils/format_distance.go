@@ -1,15 +1,126 @@
 package dateutils
 
 import (
-	"fmt"
 	"math"
 	"time"
 )
 
+// DistanceUnit is the unit FormatDistanceStrict writes a distance in.
+type DistanceUnit int
+
+const (
+	DistanceAuto DistanceUnit = iota // Pick the unit from DistanceThresholds
+	DistanceSeconds
+	DistanceMinutes
+	DistanceHours
+	DistanceDays
+	DistanceWeeks
+	DistanceMonths
+	DistanceYears
+)
+
+// RoundingMethod is how FormatDistanceStrict rounds a distance to a whole
+// number of units.
+type RoundingMethod int
+
+const (
+	RoundingTrunc RoundingMethod = iota // Toward zero: 1.9 hours is "1 hour"
+	RoundingFloor                       // Down; the same as RoundingTrunc for distances
+	RoundingCeil                        // Up: 1.1 hours is "2 hours"
+	RoundingRound                       // To nearest, halves up: 1.5 hours is "2 hours"
+)
+
+// apply rounds x with the method.
+func (m RoundingMethod) apply(x float64) int {
+	switch m {
+	case RoundingFloor:
+		return int(math.Floor(x))
+	case RoundingCeil:
+		return int(math.Ceil(x))
+	case RoundingRound:
+		return int(math.Round(x))
+	default:
+		return int(math.Trunc(x))
+	}
+}
+
+// DistanceThresholds sets where FormatDistanceStrict switches to a larger
+// unit: a distance below Minute is written in seconds, below Hour in
+// minutes, and so on up to years. Zero fields keep the default. A unit is
+// skipped when its threshold equals the next one, so setting Week and Month
+// to 60 days writes 45 days as "45 days" rather than "6 weeks".
+type DistanceThresholds struct {
+	Minute time.Duration // Default 1 minute
+	Hour   time.Duration // Default 1 hour
+	Day    time.Duration // Default 24 hours
+	Week   time.Duration // Default 7 days
+	Month  time.Duration // Default 30.44 days
+	Year   time.Duration // Default 365.2425 days
+}
+
+// defaultDistanceThresholds are the thresholds FormatDistanceStrict uses by default.
+var defaultDistanceThresholds = DistanceThresholds{
+	Minute: time.Minute,
+	Hour:   time.Hour,
+	Day:    24 * time.Hour,
+	Week:   7 * 24 * time.Hour,
+	Month:  2629746 * time.Second,
+	Year:   31556952 * time.Second,
+}
+
+// unit returns the unit for a distance.
+func (t DistanceThresholds) unit(d time.Duration) DistanceUnit {
+	pick := func(value, fallback time.Duration) time.Duration {
+		if value == 0 {
+			return fallback
+		}
+		return value
+	}
+	switch {
+	case d < pick(t.Minute, defaultDistanceThresholds.Minute):
+		return DistanceSeconds
+	case d < pick(t.Hour, defaultDistanceThresholds.Hour):
+		return DistanceMinutes
+	case d < pick(t.Day, defaultDistanceThresholds.Day):
+		return DistanceHours
+	case d < pick(t.Week, defaultDistanceThresholds.Week):
+		return DistanceDays
+	case d < pick(t.Month, defaultDistanceThresholds.Month):
+		return DistanceWeeks
+	case d < pick(t.Year, defaultDistanceThresholds.Year):
+		return DistanceMonths
+	default:
+		return DistanceYears
+	}
+}
+
 // FormatDistanceOptions represents options for formatting distance between two times.
 type FormatDistanceOptions struct {
-	IncludeSeconds bool // Include seconds in output for more precision
-	AddSuffix      bool // Add "ago" or "in" suffix to indicate past/future
+	IncludeSeconds bool    // Include seconds in output for more precision
+	AddSuffix      bool    // Add "ago" or "in" suffix to indicate past/future
+	Locale         *Locale // Phrases, plural rules and suffix templates; nil means LocaleEnUS
+
+	// IncludeWeeks makes FormatDistance write distances from 7 days up to a
+	// month in weeks ("2 weeks", "about 3 weeks") instead of days.
+	IncludeWeeks bool
+
+	// Unit forces the unit of FormatDistanceStrict, such as DistanceHours
+	// for "36 hours". DistanceAuto picks it from Thresholds.
+	Unit DistanceUnit
+
+	// RoundingMethod rounds FormatDistanceStrict values. The zero value truncates.
+	RoundingMethod RoundingMethod
+
+	// Thresholds overrides where FormatDistanceStrict switches units.
+	Thresholds DistanceThresholds
+}
+
+// locale returns the Locale to format with.
+func (o *FormatDistanceOptions) locale() *Locale {
+	if o.Locale == nil {
+		return LocaleEnUS
+	}
+	return o.Locale
 }
 
 // FormatDistance returns the distance between the given dates in words.
@@ -28,14 +139,17 @@ type FormatDistanceOptions struct {
 //   - baseDate: The date to compare with (reference point)
 //   - options: Optional formatting options
 //
-// Returns a human-readable string describing the distance between the dates.
+// Returns a human-readable string describing the distance between the dates,
+// in the language of options.Locale.
 //
 // Example:
 //
 //	now := time.Now()
 //	past := now.Add(-2 * time.Hour)
 //	result := FormatDistance(now, past, &FormatDistanceOptions{AddSuffix: true})
-//	// Returns: "about 2 hours ago"
+//	// Returns: "in about 2 hours"
+//	result = FormatDistance(past, now, &FormatDistanceOptions{AddSuffix: true, Locale: LocalePtBR})
+//	// Returns: "há cerca de 2 horas"
 func FormatDistance(date, baseDate time.Time, options *FormatDistanceOptions) string {
 	if options == nil {
 		options = &FormatDistanceOptions{}
@@ -59,84 +173,82 @@ func FormatDistance(date, baseDate time.Time, options *FormatDistanceOptions) st
 	seconds := int(math.Abs(laterDate.Sub(earlierDate).Seconds()))
 	minutes := int(math.Round(float64(seconds) / 60))
 
-	var result string
+	token, count := distanceLessThanXMinutes, 1
 
 	// 0 up to 2 minutes
 	if minutes < 2 {
 		if options.IncludeSeconds {
 			if seconds < 5 {
-				result = "less than 5 seconds"
+				token, count = distanceLessThanXSeconds, 5
 			} else if seconds < 10 {
-				result = "less than 10 seconds"
+				token, count = distanceLessThanXSeconds, 10
 			} else if seconds < 20 {
-				result = "less than 20 seconds"
+				token, count = distanceLessThanXSeconds, 20
 			} else if seconds < 40 {
-				result = "half a minute"
+				token = distanceHalfAMinute
 			} else if seconds < 60 {
-				result = "less than a minute"
+				token = distanceLessThanXMinutes
 			} else {
-				result = "1 minute"
-			}
-		} else {
-			if minutes == 0 {
-				result = "less than a minute"
-			} else {
-				result = "1 minute"
+				token = distanceXMinutes
 			}
+		} else if minutes != 0 {
+			token = distanceXMinutes
 		}
 	} else if minutes < 45 {
 		// 2 minutes up to 45 minutes
-		result = formatMinutes(minutes)
+		token, count = distanceXMinutes, minutes
 	} else if minutes < 90 {
 		// 45 minutes up to 1.5 hours
-		result = "about 1 hour"
+		token = distanceAboutXHours
 	} else if minutes < 1440 { // 24 hours
 		// 1.5 hours up to 24 hours
-		hours := int(math.Round(float64(minutes) / 60))
-		result = formatHours(hours)
+		token, count = distanceAboutXHours, int(math.Round(float64(minutes)/60))
 	} else if minutes < 2520 { // 1.75 days
 		// 1 day up to 1.75 days
-		result = "1 day"
+		token = distanceXDays
 	} else if minutes < 43200 { // 30 days
-		// 1.75 days up to 30 days
+		// 1.75 days up to 30 days, or weeks from 7 days
 		days := int(math.Round(float64(minutes) / 1440))
-		result = formatDays(days)
+		token, count = distanceXDays, days
+		if options.IncludeWeeks && days >= 7 {
+			token, count = distanceXWeeks, days/7
+			if days%7 != 0 {
+				token, count = distanceAboutXWeeks, int(math.Round(float64(days)/7))
+			}
+		}
 	} else if minutes < 86400 { // 60 days
 		// 1 month up to 2 months
-		result = "about 1 month"
+		token = distanceAboutXMonths
 	} else {
 		// Calculate months and years for longer periods
 		monthsDiff := DifferenceInMonths(laterDate, earlierDate)
 		if monthsDiff < 12 {
-			result = formatMonths(monthsDiff)
+			token, count = distanceXMonths, monthsDiff
+			if monthsDiff == 1 {
+				token = distanceAboutXMonths
+			}
 		} else {
 			years := monthsDiff / 12
 			remainingMonths := monthsDiff % 12
 
 			if remainingMonths < 3 {
-				result = formatYears(years, "about")
+				token, count = distanceAboutXYears, years
 			} else if remainingMonths < 9 {
-				result = formatYears(years, "over")
+				token, count = distanceOverXYears, years
 			} else {
-				result = formatYears(years+1, "almost")
+				token, count = distanceAlmostXYears, years+1
 			}
 		}
 	}
 
-	// Add suffix if requested
-	if options.AddSuffix {
-		if isInFuture {
-			result = "in " + result
-		} else {
-			result = result + " ago"
-		}
-	}
-
-	return result
+	return options.locale().distance(token, count, options.AddSuffix, isInFuture)
 }
 
 // FormatDistanceStrict returns the distance between dates in a strict format.
 // Unlike FormatDistance, this function always returns exact values without approximations.
+// The unit is picked from options.Thresholds unless options.Unit forces one,
+// and the value is rounded with options.RoundingMethod. Months and years
+// are counted on the calendar.
 //
 // Parameters:
 //   - date: The date to compare
@@ -151,6 +263,8 @@ func FormatDistance(date, baseDate time.Time, options *FormatDistanceOptions) st
 //	past := now.Add(-125 * time.Minute)
 //	result := FormatDistanceStrict(now, past, &FormatDistanceOptions{AddSuffix: true})
 //	// Returns: "2 hours ago" (exact, no "about")
+//	result = FormatDistanceStrict(now.Add(36*time.Hour), now, &FormatDistanceOptions{AddSuffix: true, Unit: DistanceHours})
+//	// Returns: "in 36 hours"
 func FormatDistanceStrict(date, baseDate time.Time, options *FormatDistanceOptions) string {
 	if options == nil {
 		options = &FormatDistanceOptions{}
@@ -170,57 +284,44 @@ func FormatDistanceStrict(date, baseDate time.Time, options *FormatDistanceOptio
 		isInFuture = false
 	}
 
-	// Calculate exact differences
-	totalSeconds := int(math.Abs(laterDate.Sub(earlierDate).Seconds()))
-
-	var result string
-	var value int
-	var unit string
-
-	if totalSeconds < 60 {
-		value = totalSeconds
-		unit = "second"
-	} else if totalSeconds < 3600 {
-		value = totalSeconds / 60
-		unit = "minute"
-	} else if totalSeconds < 86400 {
-		value = totalSeconds / 3600
-		unit = "hour"
-	} else if totalSeconds < 604800 {
-		value = totalSeconds / 86400
-		unit = "day"
-	} else if totalSeconds < 2629746 { // ~30.44 days
-		value = totalSeconds / 604800
-		unit = "week"
-	} else if totalSeconds < 31556952 { // ~365.25 days
-		value = DifferenceInMonths(laterDate, earlierDate)
-		unit = "month"
-	} else {
-		value = DifferenceInYears(laterDate, earlierDate)
-		unit = "year"
+	distance := laterDate.Sub(earlierDate)
+	unit := options.Unit
+	if unit == DistanceAuto {
+		unit = options.Thresholds.unit(distance)
 	}
 
-	// Format the result
-	if value == 1 {
-		if unit == "hour" {
-			result = "1 hour"
-		} else {
-			result = fmt.Sprintf("1 %s", unit)
-		}
-	} else {
-		result = fmt.Sprintf("%d %ss", value, unit)
-	}
+	var token distanceToken
+	var amount float64
 
-	// Add suffix if requested
-	if options.AddSuffix {
-		if isInFuture {
-			result = "in " + result
-		} else {
-			result = result + " ago"
-		}
+	switch unit {
+	case DistanceSeconds:
+		token, amount = distanceXSeconds, distance.Seconds()
+	case DistanceMinutes:
+		token, amount = distanceXMinutes, distance.Minutes()
+	case DistanceHours:
+		token, amount = distanceXHours, distance.Hours()
+	case DistanceDays:
+		token, amount = distanceXDays, distance.Hours()/24
+	case DistanceWeeks:
+		token, amount = distanceXWeeks, distance.Hours()/(7*24)
+	case DistanceMonths:
+		token, amount = distanceXMonths, calendarMonths(laterDate, earlierDate)
+	default:
+		token, amount = distanceXYears, calendarMonths(laterDate, earlierDate)/12
 	}
 
-	return result
+	return options.locale().distance(token, options.RoundingMethod.apply(amount), options.AddSuffix, isInFuture)
+}
+
+// calendarMonths returns the months from earlier to later, with the part of
+// the last month as a fraction of that month's length. A month that ends
+// clamped, such as Dec 30 to Feb 29, counts as whole.
+func calendarMonths(later, earlier time.Time) float64 {
+	months := DifferenceInMonths(later, earlier)
+	start := AddMonths(earlier, months)
+	end := AddMonths(earlier, months+1)
+	fraction := float64(later.Sub(start)) / float64(end.Sub(start))
+	return float64(months) + math.Max(0, fraction)
 }
 
 // FormatDistanceToNow returns the distance from the given date to now in words.
@@ -258,40 +359,3 @@ func FormatDistanceToNow(date time.Time, options *FormatDistanceOptions) string
 func FormatDistanceToNowStrict(date time.Time, options *FormatDistanceOptions) string {
 	return FormatDistanceStrict(date, time.Now(), options)
 }
-
-// Helper functions for formatting different time units
-
-func formatMinutes(minutes int) string {
-	if minutes == 1 {
-		return "1 minute"
-	}
-	return fmt.Sprintf("%d minutes", minutes)
-}
-
-func formatHours(hours int) string {
-	if hours == 1 {
-		return "about 1 hour"
-	}
-	return fmt.Sprintf("about %d hours", hours)
-}
-
-func formatDays(days int) string {
-	if days == 1 {
-		return "1 day"
-	}
-	return fmt.Sprintf("%d days", days)
-}
-
-func formatMonths(months int) string {
-	if months == 1 {
-		return "about 1 month"
-	}
-	return fmt.Sprintf("%d months", months)
-}
-
-func formatYears(years int, prefix string) string {
-	if years == 1 {
-		return fmt.Sprintf("%s 1 year", prefix)
-	}
-	return fmt.Sprintf("%s %d years", prefix, years)
-}
@@ -367,6 +367,95 @@ func TestFormatDistanceStrict(t *testing.T) {
 	}
 }
 
+func TestFormatDistanceStrictOptions(t *testing.T) {
+	baseDate := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		options  *FormatDistanceOptions
+		expected string
+	}{
+		{name: "Forced hours", date: baseDate.Add(36 * time.Hour),
+			options: &FormatDistanceOptions{AddSuffix: true, Unit: DistanceHours}, expected: "in 36 hours"},
+		{name: "Forced minutes", date: baseDate.Add(-3 * time.Hour),
+			options: &FormatDistanceOptions{AddSuffix: true, Unit: DistanceMinutes}, expected: "180 minutes ago"},
+		{name: "Forced days", date: baseDate.AddDate(0, 2, 0),
+			options: &FormatDistanceOptions{Unit: DistanceDays}, expected: "60 days"},
+		{name: "Forced seconds", date: baseDate.Add(2 * time.Minute),
+			options: &FormatDistanceOptions{Unit: DistanceSeconds}, expected: "120 seconds"},
+		{name: "Forced years below one", date: baseDate.AddDate(0, 7, 0),
+			options: &FormatDistanceOptions{Unit: DistanceYears}, expected: "0 years"},
+		{name: "Forced years rounded", date: baseDate.AddDate(0, 7, 0),
+			options: &FormatDistanceOptions{Unit: DistanceYears, RoundingMethod: RoundingRound}, expected: "1 year"},
+		{name: "Truncated by default", date: baseDate.Add(110 * time.Minute), expected: "1 hour"},
+		{name: "Floor", date: baseDate.Add(110 * time.Minute),
+			options: &FormatDistanceOptions{RoundingMethod: RoundingFloor}, expected: "1 hour"},
+		{name: "Round", date: baseDate.Add(110 * time.Minute),
+			options: &FormatDistanceOptions{RoundingMethod: RoundingRound}, expected: "2 hours"},
+		{name: "Round half up", date: baseDate.Add(90 * time.Minute),
+			options: &FormatDistanceOptions{RoundingMethod: RoundingRound}, expected: "2 hours"},
+		{name: "Ceil", date: baseDate.Add(61 * time.Minute),
+			options: &FormatDistanceOptions{RoundingMethod: RoundingCeil}, expected: "2 hours"},
+		{name: "Ceil of a whole unit", date: baseDate.Add(2 * time.Hour),
+			options: &FormatDistanceOptions{RoundingMethod: RoundingCeil}, expected: "2 hours"},
+		{name: "Calendar months rounded", date: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			options: &FormatDistanceOptions{Unit: DistanceMonths, RoundingMethod: RoundingRound}, expected: "3 months"},
+		{name: "Calendar months truncated", date: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			options: &FormatDistanceOptions{Unit: DistanceMonths}, expected: "2 months"},
+		{name: "Default thresholds switch to weeks", date: baseDate.AddDate(0, 0, 21), expected: "3 weeks"},
+		{name: "Default thresholds switch to months", date: baseDate.AddDate(0, 0, 45), expected: "1 month"},
+		{name: "Days kept below custom threshold", date: baseDate.AddDate(0, 0, 45),
+			options:  &FormatDistanceOptions{Thresholds: DistanceThresholds{Week: 60 * 24 * time.Hour, Month: 60 * 24 * time.Hour}},
+			expected: "45 days"},
+		{name: "Hours kept below custom threshold", date: baseDate.Add(36 * time.Hour),
+			options:  &FormatDistanceOptions{AddSuffix: true, Thresholds: DistanceThresholds{Day: 48 * time.Hour}},
+			expected: "in 36 hours"},
+		{name: "Unit overrides thresholds", date: baseDate.Add(36 * time.Hour),
+			options:  &FormatDistanceOptions{Unit: DistanceDays, Thresholds: DistanceThresholds{Day: 48 * time.Hour}},
+			expected: "1 day"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDistanceStrict(tt.date, baseDate, tt.options)
+			if result != tt.expected {
+				t.Errorf("FormatDistanceStrict() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatDistanceIncludeWeeks(t *testing.T) {
+	baseDate := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	weeks := &FormatDistanceOptions{IncludeWeeks: true}
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		options  *FormatDistanceOptions
+		expected string
+	}{
+		{name: "Days without weeks", date: baseDate.AddDate(0, 0, 14), expected: "14 days"},
+		{name: "Whole weeks", date: baseDate.AddDate(0, 0, 14), options: weeks, expected: "2 weeks"},
+		{name: "About weeks", date: baseDate.AddDate(0, 0, 18), options: weeks, expected: "about 3 weeks"},
+		{name: "One week", date: baseDate.AddDate(0, 0, 7), options: weeks, expected: "1 week"},
+		{name: "Below a week", date: baseDate.AddDate(0, 0, 6), options: weeks, expected: "6 days"},
+		{name: "Months unchanged", date: baseDate.AddDate(0, 0, 40), options: weeks, expected: "about 1 month"},
+		{name: "With suffix", date: baseDate.AddDate(0, 0, -21),
+			options: &FormatDistanceOptions{IncludeWeeks: true, AddSuffix: true}, expected: "3 weeks ago"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDistance(tt.date, baseDate, tt.options)
+			if result != tt.expected {
+				t.Errorf("FormatDistance() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestFormatDistanceToNow(t *testing.T) {
 	// Test that FormatDistanceToNow works correctly
 	past := time.Now().Add(-1 * time.Hour)
@@ -459,6 +548,75 @@ func TestFormatDistanceEdgeCases(t *testing.T) {
 }
 
 // Benchmark tests
+func TestFormatDistanceLocale(t *testing.T) {
+	baseDate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		locale   *Locale
+		suffix   bool
+		expected string
+	}{
+		{name: "pt-BR past", date: baseDate.Add(-2 * time.Hour), locale: LocalePtBR, suffix: true, expected: "há cerca de 2 horas"},
+		{name: "pt-BR future", date: baseDate.Add(2 * time.Hour), locale: LocalePtBR, suffix: true, expected: "em cerca de 2 horas"},
+		{name: "pt-PT future", date: baseDate.Add(3 * 24 * time.Hour), locale: LocalePtPT, suffix: true, expected: "daqui a 3 dias"},
+		{name: "es past", date: baseDate.Add(-10 * time.Minute), locale: LocaleES, suffix: true, expected: "hace 10 minutos"},
+		{name: "fr less than a minute", date: baseDate.Add(10 * time.Second), locale: LocaleFR, expected: "moins d’une minute"},
+		{name: "fr over a year", date: baseDate.AddDate(1, 4, 0), locale: LocaleFR, suffix: true, expected: "dans plus d’un an"},
+		{name: "de nominative", date: baseDate.Add(3 * 24 * time.Hour), locale: LocaleDE, expected: "3 Tage"},
+		{name: "de dative in the past", date: baseDate.Add(-3 * 24 * time.Hour), locale: LocaleDE, suffix: true, expected: "vor 3 Tagen"},
+		{name: "de singular keeps the base form", date: baseDate.Add(-25 * time.Hour), locale: LocaleDE, suffix: true, expected: "vor 1 Tag"},
+		{name: "it months", date: baseDate.AddDate(0, 5, 0), locale: LocaleIT, suffix: true, expected: "tra 5 mesi"},
+		{name: "pl few", date: baseDate.Add(-3 * time.Minute), locale: LocalePL, suffix: true, expected: "3 minuty temu"},
+		{name: "pl many", date: baseDate.Add(-5 * time.Minute), locale: LocalePL, suffix: true, expected: "5 minut temu"},
+		{name: "pl teens are many", date: baseDate.Add(-12 * time.Minute), locale: LocalePL, expected: "12 minut"},
+		{name: "pl 22 is few", date: baseDate.Add(22 * time.Minute), locale: LocalePL, suffix: true, expected: "za 22 minuty"},
+		{name: "pl accusative singular", date: baseDate.Add(time.Minute), locale: LocalePL, suffix: true, expected: "za 1 minutę"},
+		{name: "ru one", date: baseDate.Add(-21 * time.Minute), locale: LocaleRU, suffix: true, expected: "21 минуту назад"},
+		{name: "ru few", date: baseDate.Add(2 * 24 * time.Hour), locale: LocaleRU, suffix: true, expected: "через 2 дня"},
+		{name: "ru many", date: baseDate.Add(-11 * time.Minute), locale: LocaleRU, expected: "11 минут"},
+		{name: "ru years", date: baseDate.AddDate(5, 0, 0), locale: LocaleRU, expected: "около 5 лет"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDistance(tt.date, baseDate, &FormatDistanceOptions{Locale: tt.locale, AddSuffix: tt.suffix})
+			if result != tt.expected {
+				t.Errorf("FormatDistance() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatDistanceStrictLocale(t *testing.T) {
+	baseDate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		locale   *Locale
+		suffix   bool
+		expected string
+	}{
+		{name: "pt-BR hours", date: baseDate.Add(-125 * time.Minute), locale: LocalePtBR, suffix: true, expected: "há 2 horas"},
+		{name: "fr zero is singular", date: baseDate, locale: LocaleFR, expected: "0 seconde"},
+		{name: "de weeks", date: baseDate.Add(14 * 24 * time.Hour), locale: LocaleDE, suffix: true, expected: "in 2 Wochen"},
+		{name: "de years in the past", date: baseDate.AddDate(-3, 0, 0), locale: LocaleDE, suffix: true, expected: "vor 3 Jahren"},
+		{name: "ru seconds", date: baseDate.Add(-45 * time.Second), locale: LocaleRU, suffix: true, expected: "45 секунд назад"},
+		{name: "pl years", date: baseDate.AddDate(2, 0, 0), locale: LocalePL, expected: "2 lata"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDistanceStrict(tt.date, baseDate, &FormatDistanceOptions{Locale: tt.locale, AddSuffix: tt.suffix})
+			if result != tt.expected {
+				t.Errorf("FormatDistanceStrict() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
 func BenchmarkFormatDistance(b *testing.B) {
 	baseDate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
 	date := baseDate.Add(2 * time.Hour)
@@ -0,0 +1,288 @@
+package dateutils
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// DurationUnit is a unit that FormatDuration can show.
+type DurationUnit int
+
+const (
+	DurationYears DurationUnit = iota
+	DurationMonths
+	DurationWeeks
+	DurationDays
+	DurationHours
+	DurationMinutes
+	DurationSeconds
+
+	durationUnitCount = int(DurationSeconds) + 1
+)
+
+// DurationStyle selects how FormatDuration writes the units.
+type DurationStyle int
+
+const (
+	DurationStyleLong   DurationStyle = iota // 2 hours 5 minutes
+	DurationStyleShort                       // 2 hr, 5 min
+	DurationStyleNarrow                      // 2h 5m
+)
+
+// durationUnitSeconds is the average length of each unit, used to round the
+// smallest unit shown with the units below it.
+var durationUnitSeconds = [durationUnitCount]float64{
+	DurationYears:   365.2425 * 86400,
+	DurationMonths:  365.2425 * 86400 / 12,
+	DurationWeeks:   7 * 86400,
+	DurationDays:    86400,
+	DurationHours:   3600,
+	DurationMinutes: 60,
+	DurationSeconds: 1,
+}
+
+// durationCarry is how many of a unit make one of the next larger unit,
+// or zero when the two are not a fixed multiple of each other.
+var durationCarry = [durationUnitCount]int{
+	DurationMonths:  12,
+	DurationDays:    7,
+	DurationHours:   24,
+	DurationMinutes: 60,
+	DurationSeconds: 60,
+}
+
+// FormatDurationOptions represents options for FormatDuration and FormatTimeDuration.
+type FormatDurationOptions struct {
+	// Units lists the units to show. Nil shows every unit for FormatDuration
+	// and days, hours, minutes and seconds for FormatTimeDuration.
+	Units []DurationUnit
+
+	// Style selects long ("2 hours"), short ("2 hr") or narrow ("2h") units.
+	Style DurationStyle
+
+	// Zero shows units whose value is zero, between the first and last unit shown.
+	Zero bool
+
+	// Delimiter separates the units. Empty means ", " for the short style
+	// and " " for the others.
+	Delimiter string
+
+	// MaxUnits keeps at most this many consecutive units, starting at the
+	// first non-zero one. Zero keeps them all.
+	MaxUnits int
+
+	// Conjunction puts the Locale's word for "and" before the last unit.
+	Conjunction bool
+
+	// Locale provides the unit names and plural rule. Nil means LocaleEnUS.
+	Locale *Locale
+}
+
+// locale returns the Locale to format with.
+func (o *FormatDurationOptions) locale() *Locale {
+	if o.Locale == nil {
+		return LocaleEnUS
+	}
+	return o.Locale
+}
+
+// delimiter returns the separator between units.
+func (o *FormatDurationOptions) delimiter() string {
+	if o.Delimiter != "" {
+		return o.Delimiter
+	}
+	if o.Style == DurationStyleShort {
+		return ", "
+	}
+	return " "
+}
+
+// selected reports which units to show, defaulting to the given ones.
+func (o *FormatDurationOptions) selected(defaults ...DurationUnit) [durationUnitCount]bool {
+	units := o.Units
+	if len(units) == 0 {
+		units = defaults
+	}
+	var selected [durationUnitCount]bool
+	for _, unit := range units {
+		if unit >= DurationYears && unit <= DurationSeconds {
+			selected[unit] = true
+		}
+	}
+	return selected
+}
+
+// FormatDuration writes a Period as words, like date-fns formatDuration:
+// Period{Years: 2, Months: 3, Days: 4} is "2 years 3 months 4 days".
+// Options choose the units, style, delimiter and locale, whether zero units
+// are shown and how many units are kept. The smallest unit shown is rounded
+// half away from zero with whatever is below it, including nanoseconds. When
+// the rounding makes it a whole larger unit (60 seconds or minutes, 24 hours,
+// 7 days, 12 months), it carries into that unit if it is shown; the other
+// components are written as given, so Period{Days: 10} is "10 days".
+// Units left out of Units are converted to the next smaller unit shown, using
+// average month and year lengths where needed. A Period that is all zero is
+// written as zero of the smallest unit, and each negative unit with its own
+// "-": "-1 hour -30 minutes".
+//
+// Example:
+//
+//	FormatDuration(Period{Years: 2, Months: 3, Days: 4}, nil) // "2 years 3 months 4 days"
+//	FormatDuration(Period{Hours: 2, Minutes: 5}, &FormatDurationOptions{Style: DurationStyleShort}) // "2 hr, 5 min"
+//	FormatDuration(Period{Days: 1, Hours: 23, Minutes: 40}, &FormatDurationOptions{MaxUnits: 2}) // "2 days"
+//	FormatDuration(Period{Hours: 1, Minutes: 30}, &FormatDurationOptions{Conjunction: true, Locale: LocalePtBR}) // "1 hora e 30 minutos"
+func FormatDuration(p Period, options *FormatDurationOptions) string {
+	if options == nil {
+		options = &FormatDurationOptions{}
+	}
+	values := [durationUnitCount]int{p.Years, p.Months, p.Weeks, p.Days, p.Hours, p.Minutes, p.Seconds}
+	selected := options.selected(DurationYears, DurationMonths, DurationWeeks, DurationDays,
+		DurationHours, DurationMinutes, DurationSeconds)
+	return formatDurationUnits(values, float64(p.Nanos)/float64(time.Second), selected, options)
+}
+
+// FormatTimeDuration writes a time.Duration as words, splitting it into
+// the selected units: 90*time.Minute is "1 hour 30 minutes", or "90 minutes"
+// with Units set to DurationMinutes. Years and months are never used, since
+// a time.Duration has no calendar; a day is 24 hours and a week 7 days.
+// Otherwise it works like FormatDuration.
+//
+// Example:
+//
+//	FormatTimeDuration(3*time.Hour+25*time.Minute, &FormatDurationOptions{Style: DurationStyleNarrow}) // "3h 25m"
+func FormatTimeDuration(d time.Duration, options *FormatDurationOptions) string {
+	if options == nil {
+		options = &FormatDurationOptions{}
+	}
+	selected := options.selected(DurationDays, DurationHours, DurationMinutes, DurationSeconds)
+	selected[DurationYears], selected[DurationMonths] = false, false
+
+	var values [durationUnitCount]int
+	for unit := DurationWeeks; unit <= DurationSeconds; unit++ {
+		if !selected[unit] {
+			continue
+		}
+		length := time.Duration(durationUnitSeconds[unit]) * time.Second
+		values[unit] = int(d / length)
+		d %= length
+	}
+	return formatDurationUnits(values, d.Seconds(), selected, options)
+}
+
+// formatDurationUnits writes the selected units of values, with rest
+// seconds below the smallest unit left over for rounding.
+func formatDurationUnits(values [durationUnitCount]int, rest float64, selected [durationUnitCount]bool, options *FormatDurationOptions) string {
+	var shown []DurationUnit
+	for unit := DurationYears; unit <= DurationSeconds; unit++ {
+		if selected[unit] {
+			shown = append(shown, unit)
+		}
+	}
+	if len(shown) == 0 {
+		return ""
+	}
+
+	// Move the hidden units into the next smaller unit shown.
+	pending := 0.0
+	for unit := DurationYears; unit <= DurationSeconds; unit++ {
+		if !selected[unit] {
+			pending += float64(values[unit]) * durationUnitSeconds[unit]
+			values[unit] = 0
+			continue
+		}
+		whole := math.Trunc(pending / durationUnitSeconds[unit])
+		values[unit] += int(whole)
+		pending -= whole * durationUnitSeconds[unit]
+	}
+	rest += pending
+
+	first := firstNonZeroUnit(values, shown)
+	last := len(shown) - 1
+	if options.MaxUnits > 0 && first+options.MaxUnits-1 < last {
+		last = first + options.MaxUnits - 1
+	}
+	smallest := shown[last]
+
+	// Round the smallest unit kept with everything below it. Only a unit
+	// that the rounding pushed to a whole larger unit carries into it, so
+	// the caller's own components are never regrouped.
+	for unit := smallest + 1; unit <= DurationSeconds; unit++ {
+		rest += float64(values[unit]) * durationUnitSeconds[unit]
+		values[unit] = 0
+	}
+	before := values[smallest]
+	values[smallest] += int(math.Round(rest / durationUnitSeconds[smallest]))
+	for unit := smallest; unit > DurationYears; unit-- {
+		ratio := durationCarry[unit]
+		if ratio == 0 || !selected[unit-1] || absInt(before) >= ratio || absInt(values[unit]) < ratio {
+			break
+		}
+		before = values[unit-1]
+		values[unit-1] += values[unit] / ratio
+		values[unit] %= ratio
+	}
+	if carried := firstNonZeroUnit(values, shown); carried < first {
+		first = carried
+		if options.MaxUnits > 0 && first+options.MaxUnits-1 < last {
+			last = first + options.MaxUnits - 1
+		}
+	}
+
+	locale := options.locale()
+	var parts []string
+	for _, unit := range shown[first : last+1] {
+		if values[unit] != 0 || options.Zero {
+			parts = append(parts, durationPhrase(locale, options.Style, unit, values[unit]))
+		}
+	}
+	if len(parts) == 0 {
+		parts = append(parts, durationPhrase(locale, options.Style, smallest, 0))
+	}
+
+	delimiter := options.delimiter()
+	if options.Conjunction && len(parts) > 1 && locale.Conjunction != "" {
+		return strings.Join(parts[:len(parts)-1], delimiter) + " " + locale.Conjunction + " " + parts[len(parts)-1]
+	}
+	return strings.Join(parts, delimiter)
+}
+
+// firstNonZeroUnit returns the index in shown of the first unit with a
+// value, or the last index if they are all zero.
+func firstNonZeroUnit(values [durationUnitCount]int, shown []DurationUnit) int {
+	for i, unit := range shown {
+		if values[unit] != 0 {
+			return i
+		}
+	}
+	return len(shown) - 1
+}
+
+// durationPhrase writes value in a unit. A negative value is written as "-"
+// and its magnitude.
+func durationPhrase(locale *Locale, style DurationStyle, unit DurationUnit, value int) string {
+	var table DurationTable
+	switch style {
+	case DurationStyleShort:
+		table = locale.DurationShort
+	case DurationStyleNarrow:
+		table = locale.DurationNarrow
+	default:
+		table = DurationTable{
+			Years:   locale.Distance.XYears,
+			Months:  locale.Distance.XMonths,
+			Weeks:   locale.Distance.XWeeks,
+			Days:    locale.Distance.XDays,
+			Hours:   locale.Distance.XHours,
+			Minutes: locale.Distance.XMinutes,
+			Seconds: locale.Distance.XSeconds,
+		}
+	}
+
+	forms := [durationUnitCount]PluralForms{table.Years, table.Months, table.Weeks, table.Days,
+		table.Hours, table.Minutes, table.Seconds}[unit]
+	if value < 0 {
+		return "-" + locale.count(forms, -value)
+	}
+	return locale.count(forms, value)
+}
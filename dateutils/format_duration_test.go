@@ -0,0 +1,112 @@
+package dateutils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		period  Period
+		options *FormatDurationOptions
+		want    string
+	}{
+		{name: "Multiple units", period: Period{Years: 2, Months: 3, Days: 4}, want: "2 years 3 months 4 days"},
+		{name: "Singular", period: Period{Years: 1, Hours: 1, Seconds: 1}, want: "1 year 1 hour 1 second"},
+		{name: "Zero period", period: Period{}, want: "0 seconds"},
+		{name: "Zero units shown", period: Period{Hours: 2, Seconds: 5}, options: &FormatDurationOptions{Zero: true},
+			want: "2 hours 0 minutes 5 seconds"},
+		{name: "Short style", period: Period{Hours: 2, Minutes: 5}, options: &FormatDurationOptions{Style: DurationStyleShort},
+			want: "2 hr, 5 min"},
+		{name: "Narrow style", period: Period{Hours: 2, Minutes: 5}, options: &FormatDurationOptions{Style: DurationStyleNarrow},
+			want: "2h 5m"},
+		{name: "Custom delimiter", period: Period{Days: 3, Hours: 4}, options: &FormatDurationOptions{Delimiter: ", "},
+			want: "3 days, 4 hours"},
+		{name: "Chosen units", period: Period{Months: 1, Days: 2, Hours: 3},
+			options: &FormatDurationOptions{Units: []DurationUnit{DurationMonths, DurationDays}}, want: "1 month 2 days"},
+		{name: "Hidden unit converted", period: Period{Weeks: 2, Days: 3},
+			options: &FormatDurationOptions{Units: []DurationUnit{DurationDays}}, want: "17 days"},
+		{name: "Hidden year converted", period: Period{Years: 1, Months: 2},
+			options: &FormatDurationOptions{Units: []DurationUnit{DurationMonths}}, want: "14 months"},
+		{name: "Max units rounds down", period: Period{Hours: 2, Minutes: 5, Seconds: 29},
+			options: &FormatDurationOptions{MaxUnits: 1}, want: "2 hours"},
+		{name: "Max units rounds up", period: Period{Hours: 2, Minutes: 29, Seconds: 30},
+			options: &FormatDurationOptions{MaxUnits: 2}, want: "2 hours 30 minutes"},
+		{name: "Rounding carries", period: Period{Days: 1, Hours: 23, Minutes: 40},
+			options: &FormatDurationOptions{MaxUnits: 2}, want: "2 days"},
+		{name: "Carry past the window", period: Period{Hours: 23, Minutes: 59, Seconds: 40},
+			options: &FormatDurationOptions{MaxUnits: 2}, want: "1 day"},
+		{name: "Max units counts from the first non-zero unit", period: Period{Days: 1, Minutes: 40},
+			options: &FormatDurationOptions{MaxUnits: 2}, want: "1 day 1 hour"},
+		{name: "Nanoseconds round seconds", period: Period{Seconds: 1, Nanos: 500_000_000}, want: "2 seconds"},
+		{name: "Components are not regrouped", period: Period{Days: 10}, want: "10 days"},
+		{name: "Minutes are not regrouped", period: Period{Minutes: 90}, want: "90 minutes"},
+		{name: "Months are not regrouped", period: Period{Months: 14}, want: "14 months"},
+		{name: "Rounding carries into weeks", period: Period{Days: 6, Hours: 20},
+			options: &FormatDurationOptions{Units: []DurationUnit{DurationWeeks, DurationDays}}, want: "1 week"},
+		{name: "Negative", period: Period{Hours: -1, Minutes: -30}, want: "-1 hour -30 minutes"},
+		{name: "Mixed signs", period: Period{Months: 1, Days: -2}, want: "1 month -2 days"},
+		{name: "Conjunction", period: Period{Hours: 1, Minutes: 30, Seconds: 5},
+			options: &FormatDurationOptions{Delimiter: ", ", Conjunction: true}, want: "1 hour, 30 minutes and 5 seconds"},
+		{name: "Conjunction with one unit", period: Period{Hours: 1}, options: &FormatDurationOptions{Conjunction: true},
+			want: "1 hour"},
+		{name: "Portuguese conjunction", period: Period{Hours: 1, Minutes: 30},
+			options: &FormatDurationOptions{Conjunction: true, Locale: LocalePtBR}, want: "1 hora e 30 minutos"},
+		{name: "Russian plural forms", period: Period{Years: 5, Days: 2, Minutes: 21},
+			options: &FormatDurationOptions{Locale: LocaleRU}, want: "5 лет 2 дня 21 минута"},
+		{name: "Russian short plural forms", period: Period{Years: 5, Days: 2},
+			options: &FormatDurationOptions{Style: DurationStyleShort, Locale: LocaleRU}, want: "5 л., 2 дн."},
+		{name: "German short", period: Period{Hours: 3, Minutes: 15},
+			options: &FormatDurationOptions{Style: DurationStyleShort, Locale: LocaleDE}, want: "3 Std., 15 Min."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatDuration(tt.period, tt.options); got != tt.want {
+				t.Errorf("FormatDuration(%v) = %q, want %q", tt.period, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatTimeDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration time.Duration
+		options  *FormatDurationOptions
+		want     string
+	}{
+		{name: "Hours and minutes", duration: 90 * time.Minute, want: "1 hour 30 minutes"},
+		{name: "Days", duration: 50 * time.Hour, want: "2 days 2 hours"},
+		{name: "Chosen unit", duration: 90 * time.Minute,
+			options: &FormatDurationOptions{Units: []DurationUnit{DurationMinutes}}, want: "90 minutes"},
+		{name: "Weeks", duration: 10 * 24 * time.Hour,
+			options: &FormatDurationOptions{Units: []DurationUnit{DurationWeeks, DurationDays}}, want: "1 week 3 days"},
+		{name: "Years and months ignored", duration: 26 * time.Hour,
+			options: &FormatDurationOptions{Units: []DurationUnit{DurationMonths, DurationHours}}, want: "26 hours"},
+		{name: "Narrow", duration: 3*time.Hour + 25*time.Minute,
+			options: &FormatDurationOptions{Style: DurationStyleNarrow}, want: "3h 25m"},
+		{name: "Sub-second rounding", duration: 2500 * time.Millisecond, want: "3 seconds"},
+		{name: "Max units", duration: 2*time.Hour + 5*time.Minute + 40*time.Second,
+			options: &FormatDurationOptions{MaxUnits: 2, Style: DurationStyleShort}, want: "2 hr, 6 min"},
+		{name: "Negative", duration: -(2*time.Hour + 5*time.Minute), want: "-2 hours -5 minutes"},
+		{name: "Zero", duration: 0, want: "0 seconds"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatTimeDuration(tt.duration, tt.options); got != tt.want {
+				t.Errorf("FormatTimeDuration(%v) = %q, want %q", tt.duration, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkFormatDuration(b *testing.B) {
+	period := Period{Years: 2, Months: 3, Days: 4, Hours: 5}
+	options := &FormatDurationOptions{MaxUnits: 3, Conjunction: true}
+	for i := 0; i < b.N; i++ {
+		_ = FormatDuration(period, options)
+	}
+}
@@ -0,0 +1,67 @@
+package dateutils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateStyle selects one of a Locale's default date patterns.
+type DateStyle int
+
+const (
+	DateStyleNone   DateStyle = iota // Omit the date
+	DateStyleShort                   // 03/21/2024, 21/03/2024
+	DateStyleMedium                  // Mar 21, 2024
+	DateStyleLong                    // March 21st, 2024
+	DateStyleFull                    // Thursday, March 21st, 2024
+)
+
+// TimeStyle selects one of a Locale's default time patterns.
+type TimeStyle int
+
+const (
+	TimeStyleNone   TimeStyle = iota // Omit the time
+	TimeStyleShort                   // 2:05 PM, 14:05
+	TimeStyleMedium                  // 2:05:00 PM
+	TimeStyleLong                    // 2:05:00 PM GMT-3
+	TimeStyleFull                    // 2:05:00 PM GMT-03:00
+)
+
+// FormatLocale formats a time with the date-fns token grammar of FormatTokens,
+// using the month, weekday, meridiem and era names, ordinals, week rules and
+// P/p patterns of the given locale. A nil locale means LocaleEnUS.
+// Returns a *TokenError for invalid patterns, or an error wrapping
+// ErrZeroTime or ErrEmptyFormat.
+//
+// Example:
+//
+//	date := time.Date(2024, time.March, 21, 14, 5, 0, 0, time.UTC)
+//	FormatLocale(date, "EEEE, d 'de' MMMM 'de' yyyy", LocalePtBR) // "quinta-feira, 21 de março de 2024"
+//	FormatLocale(date, "EEEE do MMMM", LocaleDE)                  // "Donnerstag 21. März"
+func FormatLocale(t time.Time, pattern string, locale *Locale) (string, error) {
+	return FormatTokens(t, pattern, &FormatTokensOptions{Locale: locale})
+}
+
+// FormatStyle formats a time with the default date and time patterns of the
+// given locale, like the P and p tokens. Pass DateStyleNone or TimeStyleNone
+// to format only the time or only the date. A nil locale means LocaleEnUS.
+// Returns an error wrapping ErrEmptyFormat if both styles are None,
+// or ErrZeroTime for the zero time.
+//
+// Example:
+//
+//	date := time.Date(2024, time.March, 21, 14, 5, 0, 0, time.UTC)
+//	FormatStyle(date, DateStyleLong, TimeStyleShort, LocaleEnUS) // "March 21st, 2024 at 2:05 PM"
+//	FormatStyle(date, DateStyleLong, TimeStyleShort, LocalePtBR) // "21 de março de 2024 às 14:05"
+func FormatStyle(t time.Time, dateStyle DateStyle, timeStyle TimeStyle, locale *Locale) (string, error) {
+	if dateStyle < DateStyleNone || dateStyle > DateStyleFull {
+		return "", fmt.Errorf("invalid date style %d", dateStyle)
+	}
+	if timeStyle < TimeStyleNone || timeStyle > TimeStyleFull {
+		return "", fmt.Errorf("invalid time style %d", timeStyle)
+	}
+
+	pattern := strings.Repeat("P", int(dateStyle)) + strings.Repeat("p", int(timeStyle))
+	return FormatLocale(t, pattern, locale)
+}
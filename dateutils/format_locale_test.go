@@ -0,0 +1,130 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFormatLocale(t *testing.T) {
+	date := time.Date(2024, time.March, 21, 14, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		pattern string
+		locale  *Locale
+		want    string
+	}{
+		{name: "Nil is en-US", pattern: "EEEE, MMMM do", locale: nil, want: "Thursday, March 21st"},
+		{name: "pt-BR names", pattern: "EEEE, d 'de' MMMM 'de' yyyy", locale: LocalePtBR, want: "quinta-feira, 21 de março de 2024"},
+		{name: "pt-PT abbreviated", pattern: "EEE, d MMM", locale: LocalePtPT, want: "qui, 21 mar"},
+		{name: "es names", pattern: "EEEE d 'de' MMMM", locale: LocaleES, want: "jueves 21 de marzo"},
+		{name: "fr names", pattern: "EEEE d MMMM", locale: LocaleFR, want: "jeudi 21 mars"},
+		{name: "fr first of month", pattern: "do MMMM", locale: LocaleFR, want: "21e mars"},
+		{name: "de names and ordinal", pattern: "EEEE, do MMMM", locale: LocaleDE, want: "Donnerstag, 21. März"},
+		{name: "it names", pattern: "EEEE d MMMM", locale: LocaleIT, want: "giovedì 21 marzo"},
+		{name: "de meridiem", pattern: "h:mm a", locale: LocaleDE, want: "2:05 nachm."},
+		{name: "pt-BR day period", pattern: "h 'horas' B", locale: LocalePtBR, want: "2 horas da tarde"},
+		{name: "es era", pattern: "yyyy G", locale: LocaleES, want: "2024 d. C."},
+		{name: "fr quarter", pattern: "QQQQ", locale: LocaleFR, want: "1er trimestre"},
+		{name: "pt-BR week ordinal", pattern: "wo 'semana'", locale: LocalePtBR, want: "12ª semana"},
+		{name: "de long date", pattern: "PPP", locale: LocaleDE, want: "21. März 2024"},
+		{name: "en-GB short date", pattern: "P", locale: LocaleEnGB, want: "21/03/2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatLocale(date, tt.pattern, tt.locale)
+			if err != nil {
+				t.Fatalf("FormatLocale() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatLocale(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatLocaleWeekRule(t *testing.T) {
+	// Sunday 2024-12-29: week 1 of 2025 in en-US, week 52 of 2024 under ISO-like rules.
+	date := time.Date(2024, time.December, 29, 0, 0, 0, 0, time.UTC)
+
+	if got, _ := FormatLocale(date, "Y-w", LocaleEnUS); got != "2025-1" {
+		t.Errorf("en-US week = %q, want %q", got, "2025-1")
+	}
+	if got, _ := FormatLocale(date, "Y-w", LocaleDE); got != "2024-52" {
+		t.Errorf("de week = %q, want %q", got, "2024-52")
+	}
+	sunday, monday := time.Sunday, time.Monday
+	got, _ := FormatTokens(date, "Y-w", &FormatTokensOptions{Locale: LocaleEnUS, WeekStartsOn: &monday, FirstWeekContainsDate: 4})
+	if got != "2024-52" {
+		t.Errorf("explicit week rule = %q, want %q", got, "2024-52")
+	}
+	// Fields left unset still come from the Locale.
+	got, _ = FormatTokens(date, "Y-w e", &FormatTokensOptions{Locale: LocaleEnUS, WeekStartsOn: &monday})
+	if got != "2024-52 7" {
+		t.Errorf("explicit week start = %q, want %q", got, "2024-52 7")
+	}
+	// Friday 2027-01-01 is in week 53 of 2026 under the de rule.
+	got, _ = FormatTokens(time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), "Y-w e",
+		&FormatTokensOptions{Locale: LocaleDE, FirstWeekContainsDate: 1})
+	if got != "2027-1 5" {
+		t.Errorf("explicit first week = %q, want %q", got, "2027-1 5")
+	}
+	// An explicit Sunday overrides a Monday-start Locale.
+	got, _ = FormatTokens(date, "Y-w e", &FormatTokensOptions{Locale: LocaleDE, WeekStartsOn: &sunday, FirstWeekContainsDate: 1})
+	if got != "2025-1 1" {
+		t.Errorf("explicit Sunday week start = %q, want %q", got, "2025-1 1")
+	}
+}
+
+func TestFormatStyle(t *testing.T) {
+	date := time.Date(2024, time.March, 21, 14, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		dateStyle DateStyle
+		timeStyle TimeStyle
+		locale    *Locale
+		want      string
+		wantErr   bool
+	}{
+		{name: "en-US long and short", dateStyle: DateStyleLong, timeStyle: TimeStyleShort, locale: LocaleEnUS, want: "March 21st, 2024 at 2:05 PM"},
+		{name: "en-US short date", dateStyle: DateStyleShort, locale: nil, want: "03/21/2024"},
+		{name: "en-GB medium", dateStyle: DateStyleMedium, timeStyle: TimeStyleMedium, locale: LocaleEnGB, want: "21 Mar 2024, 14:05:00"},
+		{name: "pt-BR long and short", dateStyle: DateStyleLong, timeStyle: TimeStyleShort, locale: LocalePtBR, want: "21 de março de 2024 às 14:05"},
+		{name: "pt-PT full", dateStyle: DateStyleFull, locale: LocalePtPT, want: "quinta-feira, 21 de março de 2024"},
+		{name: "es medium", dateStyle: DateStyleMedium, timeStyle: TimeStyleShort, locale: LocaleES, want: "21 mar 2024, 14:05"},
+		{name: "fr full and short", dateStyle: DateStyleFull, timeStyle: TimeStyleShort, locale: LocaleFR, want: "jeudi 21 mars 2024 à 14:05"},
+		{name: "de long and short", dateStyle: DateStyleLong, timeStyle: TimeStyleShort, locale: LocaleDE, want: "21. März 2024 um 14:05"},
+		{name: "it short", dateStyle: DateStyleShort, timeStyle: TimeStyleShort, locale: LocaleIT, want: "21/03/2024, 14:05"},
+		{name: "Time only", timeStyle: TimeStyleLong, locale: LocaleDE, want: "14:05:00 GMT+0"},
+		{name: "Neither", wantErr: true},
+		{name: "Invalid date style", dateStyle: DateStyle(9), wantErr: true},
+		{name: "Invalid time style", timeStyle: TimeStyle(-1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatStyle(date, tt.dateStyle, tt.timeStyle, tt.locale)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FormatStyle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FormatStyle() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := FormatStyle(date, DateStyleNone, TimeStyleNone, nil); !errors.Is(err, ErrEmptyFormat) {
+		t.Errorf("FormatStyle(None, None) error = %v, want ErrEmptyFormat", err)
+	}
+}
+
+func BenchmarkFormatStyle(b *testing.B) {
+	date := time.Date(2024, time.March, 21, 14, 5, 0, 0, time.UTC)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = FormatStyle(date, DateStyleLong, TimeStyleShort, LocalePtBR)
+	}
+}
@@ -0,0 +1,93 @@
+package dateutils
+
+import (
+	"fmt"
+	"time"
+)
+
+// FormatRelativeOptions represents options for FormatRelative.
+type FormatRelativeOptions struct {
+	// Location decides which calendar day both dates fall on, as in IsToday,
+	// and the date is formatted in it. Nil uses the base date's location.
+	Location *time.Location
+
+	// Locale provides the RelativeTable patterns and names. Nil means LocaleEnUS.
+	Locale *Locale
+
+	// WeekStartsOn is the first day of the week, which decides whether a day
+	// 2 to 6 days away is in the same week as the base date for locales with
+	// ThisWeekdays patterns. Nil means the Locale's week start.
+	WeekStartsOn *time.Weekday
+}
+
+// locale returns the Locale to format with.
+func (o *FormatRelativeOptions) locale() *Locale {
+	if o.Locale == nil {
+		return LocaleEnUS
+	}
+	return o.Locale
+}
+
+// weekStartsOn returns the first day of the week.
+func (o *FormatRelativeOptions) weekStartsOn() time.Weekday {
+	if o.WeekStartsOn != nil {
+		return *o.WeekStartsOn
+	}
+	return o.locale().WeekStartsOn
+}
+
+// FormatRelative formats a date in words relative to a base date, like
+// date-fns formatRelative. The phrase depends on the calendar days between
+// them: "yesterday at", "today at" and "tomorrow at" for adjacent days,
+// "last Friday at" and "Thursday at" up to 6 days either way, and the
+// Locale's short date pattern beyond that. The patterns come from the
+// Locale's RelativeTable.
+// Returns an error wrapping ErrZeroTime if either time is zero.
+//
+// Example:
+//
+//	base := time.Date(2024, time.March, 21, 12, 0, 0, 0, time.UTC) // Thursday
+//	FormatRelative(base.Add(-21*time.Hour), base, nil)              // "yesterday at 3:00 PM"
+//	FormatRelative(base.AddDate(0, 0, -6), base, nil)               // "last Friday at 12:00 PM"
+//	FormatRelative(base.AddDate(0, 0, 7), base, nil)                // "03/28/2024"
+//	FormatRelative(base.Add(2*time.Hour), base, &FormatRelativeOptions{Locale: LocalePtBR}) // "hoje às 14:00"
+func FormatRelative(t, base time.Time, options *FormatRelativeOptions) (string, error) {
+	if t.IsZero() || base.IsZero() {
+		return "", fmt.Errorf("cannot format relative date: %w", ErrZeroTime)
+	}
+	if options == nil {
+		options = &FormatRelativeOptions{}
+	}
+
+	location := options.Location
+	if location == nil {
+		location = base.Location()
+	}
+	t, base = t.In(location), base.In(location)
+
+	days := DifferenceInCalendarDays(t, base)
+	category := relativeOther
+	switch {
+	case days < -6:
+	case days < -1:
+		category = relativeLastWeek
+	case days < 0:
+		category = relativeYesterday
+	case days < 1:
+		category = relativeToday
+	case days < 2:
+		category = relativeTomorrow
+	case days < 7:
+		category = relativeNextWeek
+	}
+
+	// Both dates are in the same week when they are the same number of days
+	// apart as their offsets from the start of the week.
+	weekStart := int(options.weekStartsOn())
+	offset := func(d time.Time) int { return (int(d.Weekday()) - weekStart + 7) % 7 }
+	sameWeek := days == offset(t)-offset(base)
+
+	locale := options.locale()
+	pattern := locale.Relative.pattern(category, t.Weekday(), sameWeek)
+	return FormatTokens(t, pattern, &FormatTokensOptions{Locale: locale})
+}
@@ -0,0 +1,108 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFormatRelative(t *testing.T) {
+	// Thursday, 21 March 2024
+	base := time.Date(2024, time.March, 21, 12, 0, 0, 0, time.UTC)
+	at := func(days, hour, minute int) time.Time {
+		return time.Date(2024, time.March, 21+days, hour, minute, 0, 0, time.UTC)
+	}
+	sunday, saturday := time.Sunday, time.Saturday
+
+	tests := []struct {
+		name    string
+		date    time.Time
+		options *FormatRelativeOptions
+		want    string
+	}{
+		{name: "Seven days before", date: at(-7, 9, 0), want: "03/14/2024"},
+		{name: "Six days before", date: at(-6, 10, 0), want: "last Friday at 10:00 AM"},
+		{name: "Two days before", date: at(-2, 18, 45), want: "last Tuesday at 6:45 PM"},
+		{name: "Yesterday", date: at(-1, 15, 4), want: "yesterday at 3:04 PM"},
+		{name: "Earlier today", date: at(0, 0, 0), want: "today at 12:00 AM"},
+		{name: "Later today", date: at(0, 23, 59), want: "today at 11:59 PM"},
+		{name: "Tomorrow", date: at(1, 9, 30), want: "tomorrow at 9:30 AM"},
+		{name: "Six days after", date: at(6, 14, 0), want: "Wednesday at 2:00 PM"},
+		{name: "Seven days after", date: at(7, 14, 0), want: "03/28/2024"},
+		{name: "Portuguese", date: at(0, 14, 0), options: &FormatRelativeOptions{Locale: LocalePtBR},
+			want: "hoje às 14:00"},
+		{name: "Portuguese masculine weekday", date: at(-4, 8, 0), options: &FormatRelativeOptions{Locale: LocalePtBR},
+			want: "último domingo às 08:00"},
+		{name: "Portuguese feminine weekday", date: at(-3, 8, 0), options: &FormatRelativeOptions{Locale: LocalePtBR},
+			want: "última segunda-feira às 08:00"},
+		{name: "German", date: at(-2, 8, 0), options: &FormatRelativeOptions{Locale: LocaleDE},
+			want: "letzten Dienstag um 08:00"},
+		{name: "French far away", date: at(10, 8, 0), options: &FormatRelativeOptions{Locale: LocaleFR},
+			want: "31/03/2024"},
+		{name: "Russian same week", date: at(-2, 8, 0), options: &FormatRelativeOptions{Locale: LocaleRU},
+			want: "во вторник в 8:00"},
+		{name: "Russian previous week", date: at(-4, 8, 0), options: &FormatRelativeOptions{Locale: LocaleRU},
+			want: "в прошлое воскресенье в 8:00"},
+		{name: "Russian with Saturday week start", date: at(-4, 8, 0),
+			options: &FormatRelativeOptions{Locale: LocaleRU, WeekStartsOn: &saturday},
+			want:    "в воскресенье в 8:00"},
+		{name: "Russian with Sunday week start", date: at(-4, 8, 0),
+			options: &FormatRelativeOptions{Locale: LocaleRU, WeekStartsOn: &sunday},
+			want:    "в воскресенье в 8:00"},
+		{name: "Polish next week", date: at(4, 8, 0), options: &FormatRelativeOptions{Locale: LocalePL},
+			want: "w następny poniedziałek o 08:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatRelative(tt.date, base, tt.options)
+			if err != nil {
+				t.Fatalf("FormatRelative() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatRelative() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := FormatRelative(time.Time{}, base, nil); !errors.Is(err, ErrZeroTime) {
+		t.Errorf("FormatRelative(zero) error = %v, want wrapped ErrZeroTime", err)
+	}
+}
+
+func TestFormatRelativeLocation(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	// 20:00 UTC on 21 March is already 22 March in Tokyo.
+	base := time.Date(2024, time.March, 21, 20, 0, 0, 0, time.UTC)
+	date := time.Date(2024, time.March, 21, 10, 0, 0, 0, time.UTC)
+
+	got, _ := FormatRelative(date, base, nil)
+	if got != "today at 10:00 AM" {
+		t.Errorf("FormatRelative() in UTC = %q, want %q", got, "today at 10:00 AM")
+	}
+	got, _ = FormatRelative(date, base, &FormatRelativeOptions{Location: tokyo})
+	if got != "yesterday at 7:00 PM" {
+		t.Errorf("FormatRelative() in Tokyo = %q, want %q", got, "yesterday at 7:00 PM")
+	}
+
+	// Relative to now, the phrase agrees with IsToday, IsYesterday and IsTomorrow.
+	now := time.Now()
+	for _, days := range []int{-1, 0, 1} {
+		date := now.AddDate(0, 0, days)
+		got, _ := FormatRelative(date, now, &FormatRelativeOptions{Location: tokyo})
+		want := map[int]bool{-1: IsYesterday(date, tokyo), 0: IsToday(date, tokyo), 1: IsTomorrow(date, tokyo)}[days]
+		prefix := map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"}[days]
+		if want != (len(got) > len(prefix) && got[:len(prefix)] == prefix) {
+			t.Errorf("FormatRelative(%d days) = %q, inconsistent with Is* helpers", days, got)
+		}
+	}
+}
+
+func BenchmarkFormatRelative(b *testing.B) {
+	base := time.Date(2024, time.March, 21, 12, 0, 0, 0, time.UTC)
+	date := base.AddDate(0, 0, -3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = FormatRelative(date, base, nil)
+	}
+}
@@ -0,0 +1,731 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnknownToken is returned when a token pattern contains an unescaped latin
+// letter that is not part of the date-fns format grammar.
+var ErrUnknownToken = errors.New("unknown format token")
+
+// ErrAmbiguousToken is returned when a token pattern uses a token that is easy to
+// confuse with a more common one (YY/YYYY week-numbering years, D/DD days of the
+// year) without explicitly opting in through FormatTokensOptions.
+var ErrAmbiguousToken = errors.New("ambiguous format token")
+
+// TokenError describes a problem found while tokenizing a date-fns style pattern.
+// It wraps ErrUnknownToken or ErrAmbiguousToken, so callers can use errors.Is.
+type TokenError struct {
+	Pattern    string // The full pattern being tokenized
+	Token      string // The offending token
+	Offset     int    // Byte offset of the token within Pattern
+	Suggestion string // Token that was most likely intended, if any
+	Err        error  // ErrUnknownToken or ErrAmbiguousToken
+}
+
+// Error implements the error interface.
+func (e *TokenError) Error() string {
+	msg := e.Err.Error() + " " + strconv.Quote(e.Token) + " at offset " + strconv.Itoa(e.Offset) +
+		" in pattern " + strconv.Quote(e.Pattern)
+	if e.Suggestion != "" {
+		msg += "; use " + strconv.Quote(e.Suggestion) + " instead"
+	}
+	return msg
+}
+
+// Unwrap returns the sentinel error describing the kind of problem.
+func (e *TokenError) Unwrap() error {
+	return e.Err
+}
+
+// FormatTokensOptions represents options for FormatTokens.
+type FormatTokensOptions struct {
+	Timezone *time.Location // Convert the time to this location before formatting
+
+	// Locale provides the names, ordinals and P/p patterns. Nil means LocaleEnUS.
+	Locale *Locale
+
+	// WeekStartsOn is the first day of the week used by the local week tokens
+	// (Y, w, e, c). Nil means the Locale's week start, or Sunday, matching
+	// date-fns en-US, when no Locale is set.
+	WeekStartsOn *time.Weekday
+
+	// FirstWeekContainsDate is the day of January that is always in the first
+	// local week of the year (1-7). Zero means 1, matching date-fns en-US;
+	// when it is zero and a Locale is set, the Locale's rule is used.
+	FirstWeekContainsDate int
+
+	// UseAdditionalWeekYearTokens allows YY and YYYY, which are usually typos for yy and yyyy.
+	UseAdditionalWeekYearTokens bool
+
+	// UseAdditionalDayOfYearTokens allows D and DD, which are usually typos for d and dd.
+	UseAdditionalDayOfYearTokens bool
+
+	// NumberingSystem writes the digits of every token, but not of quoted
+	// literals, in a native numbering system such as NumberingArabic.
+	// Empty means the Locale's NumberingSystem.
+	NumberingSystem NumberingSystem
+}
+
+// locale returns the Locale to format with.
+func (o *FormatTokensOptions) locale() *Locale {
+	if o.Locale == nil {
+		return LocaleEnUS
+	}
+	return o.Locale
+}
+
+// numberingSystem returns the digits to format with.
+func (o *FormatTokensOptions) numberingSystem() NumberingSystem {
+	if o.NumberingSystem == "" && o.Locale != nil {
+		return o.Locale.NumberingSystem
+	}
+	return o.NumberingSystem
+}
+
+// weekRule returns the first day of the week and the first-week rule,
+// taking each one the caller left unset from the Locale.
+func (o *FormatTokensOptions) weekRule() (time.Weekday, int) {
+	weekStartsOn, firstWeekContainsDate := time.Sunday, o.FirstWeekContainsDate
+	if o.WeekStartsOn != nil {
+		weekStartsOn = *o.WeekStartsOn
+	} else if o.Locale != nil {
+		weekStartsOn = o.Locale.WeekStartsOn
+	}
+	if firstWeekContainsDate == 0 && o.Locale != nil {
+		firstWeekContainsDate = o.Locale.FirstWeekContainsDate
+	}
+	return weekStartsOn, firstWeekContainsDate
+}
+
+// patternToken is a single element of a tokenized date-fns pattern.
+// A zero letter means the token is a literal.
+type patternToken struct {
+	letter   byte
+	length   int
+	modifier byte // 'o', 'n' or 'N' for ordinal and spelled-out tokens such as "do"
+	aux      int  // length of the time part of combined "Pp" tokens
+	literal  string
+	offset   int
+}
+
+// formatTokenLetters lists every letter accepted by the token grammar.
+const formatTokenLetters = "GyYRuQqMLwIdDEeciabBhHKkmsSXxOztTPp"
+
+// ordinalTokenLetters lists the letters that accept the "o" ordinal suffix
+// and the "n" and "N" spelled-out suffixes.
+const ordinalTokenLetters = "yYQqMLwIdDecihHKkms"
+
+// text returns the token as it is written in a pattern.
+func (tok patternToken) text() string {
+	if tok.letter == 0 {
+		return tok.literal
+	}
+	text := strings.Repeat(string(tok.letter), tok.length)
+	if tok.modifier != 0 {
+		text += string(tok.modifier)
+	}
+	return text
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// tokenizePattern splits a date-fns pattern into tokens and literals.
+// Text between single quotes is literal and two consecutive single quotes produce
+// one quote, both inside and outside a quoted section. An unterminated quoted
+// section runs to the end of the pattern, as in date-fns.
+func tokenizePattern(pattern string, weekYearTokens, dayOfYearTokens bool) ([]patternToken, error) {
+	var tokens []patternToken
+	var lit strings.Builder
+	litStart := -1
+
+	flush := func() {
+		if litStart >= 0 {
+			tokens = append(tokens, patternToken{literal: lit.String(), offset: litStart})
+			lit.Reset()
+			litStart = -1
+		}
+	}
+	addLiteral := func(s string, offset int) {
+		if litStart < 0 {
+			litStart = offset
+		}
+		lit.WriteString(s)
+	}
+
+	i := 0
+	for i < len(pattern) {
+		c := pattern[i]
+
+		if c == '\'' {
+			if i+1 < len(pattern) && pattern[i+1] == '\'' {
+				addLiteral("'", i)
+				i += 2
+				continue
+			}
+			start := i
+			i++
+			for i < len(pattern) {
+				if pattern[i] == '\'' {
+					if i+1 < len(pattern) && pattern[i+1] == '\'' {
+						addLiteral("'", start)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				j := i
+				for j < len(pattern) && pattern[j] != '\'' {
+					j++
+				}
+				addLiteral(pattern[i:j], start)
+				i = j
+			}
+			continue
+		}
+
+		if !isASCIILetter(c) {
+			j := i + 1
+			for j < len(pattern) && pattern[j] != '\'' && !isASCIILetter(pattern[j]) {
+				j++
+			}
+			addLiteral(pattern[i:j], i)
+			i = j
+			continue
+		}
+
+		flush()
+		n := 1
+		for i+n < len(pattern) && pattern[i+n] == c {
+			n++
+		}
+
+		if n == 1 && i+1 < len(pattern) && strings.IndexByte("onN", pattern[i+1]) >= 0 && strings.IndexByte(ordinalTokenLetters, c) >= 0 {
+			tokens = append(tokens, patternToken{letter: c, length: 1, modifier: pattern[i+1], offset: i})
+			i += 2
+			continue
+		}
+
+		if strings.IndexByte(formatTokenLetters, c) < 0 {
+			return nil, &TokenError{Pattern: pattern, Token: pattern[i : i+n], Offset: i, Err: ErrUnknownToken}
+		}
+
+		tok := patternToken{letter: c, length: n, offset: i}
+		i += n
+
+		// date-fns treats a run of P followed by a run of p as one date-time token
+		if c == 'P' && i < len(pattern) && pattern[i] == 'p' {
+			m := 1
+			for i+m < len(pattern) && pattern[i+m] == 'p' {
+				m++
+			}
+			tok.aux = m
+			i += m
+		}
+
+		if (c == 'Y' && (n == 2 || n == 4) && !weekYearTokens) || (c == 'D' && n <= 2 && !dayOfYearTokens) {
+			text := pattern[tok.offset : tok.offset+n]
+			return nil, &TokenError{Pattern: pattern, Token: text, Offset: tok.offset,
+				Suggestion: strings.ToLower(text), Err: ErrAmbiguousToken}
+		}
+
+		tokens = append(tokens, tok)
+	}
+	flush()
+
+	return tokens, nil
+}
+
+// FormatTokens formats a time using the date-fns format token grammar
+// (a subset of Unicode Technical Standard #35). Unlike FormatCustom, the pattern
+// is tokenized rather than rewritten with string replacements, so literal text
+// can be wrapped in single quotes and letters outside the grammar are
+// reported instead of silently passed through.
+//
+// Supported tokens:
+//
+//	G..GGGGG        era (AD, Anno Domini, A)
+//	y, yo, yy, yyyy calendar year
+//	Y, Yo, YY, YYYY local week-numbering year (YY/YYYY need UseAdditionalWeekYearTokens)
+//	R..RRRR         ISO week-numbering year
+//	u..uuuu         extended year
+//	Q..QQQQQ, q     quarter (1, 01, 1st, Q1, 1st quarter, 1)
+//	M..MMMMM, L     month (1, 01, 1st, Jan, January, J)
+//	w, wo, ww       local week of year
+//	I, Io, II       ISO week of year
+//	d, do, dd       day of month
+//	D, Do, DD, DDD  day of year (D/DD need UseAdditionalDayOfYearTokens)
+//	E..EEEEEE       day of week (Tue, Tuesday, T, Tu)
+//	e, c            local day of week (number or name)
+//	i..iiiiii       ISO day of week (2, 02, 2nd, Tue, Tuesday, T, Tu)
+//	a..aaaaa        AM, PM / am, pm / a.m., p.m. / a, p
+//	b..bbbbb        AM, PM, noon, midnight
+//	B..BBBBB        flexible day period (in the morning, at night)
+//	h, H, K, k      hour (1-12, 0-23, 0-11, 1-24), with o and padding variants
+//	m, s            minute and second, with o and padding variants
+//	S..             fraction of second (S = tenths, SSS = milliseconds, up to 9 digits)
+//	X..XXXXX        ISO offset with Z for UTC (Z, -08, -0800, -08:00)
+//	x..xxxxx        ISO offset without Z (+00, +0000, +00:00)
+//	O, OOOO         GMT offset (GMT-8, GMT-08:00)
+//	z..zzzz         zone offset (GMT-8, GMT-08:00)
+//	t, T            Unix timestamp in seconds and milliseconds
+//	P..PPPP, p..pppp localized date and time, combinable as Pp
+//
+// Every token that accepts o also accepts n and N, which spell the number out
+// as a cardinal or ordinal with the Locale's SpellOut rule: "dn" is
+// "twenty-one" and "dN" is "twenty-first". Locales that cannot spell numbers
+// fall back to digits for n and to the o form for N.
+//
+// Returns a *TokenError wrapping ErrUnknownToken or ErrAmbiguousToken for invalid patterns.
+//
+// Example:
+//
+//	FormatTokens(time.Date(2024, 3, 21, 14, 5, 0, 0, time.UTC), "EEEE, MMMM do yyyy 'at' h:mm a", nil)
+//	// Returns: "Thursday, March 21st 2024 at 2:05 PM"
+func FormatTokens(t time.Time, pattern string, options *FormatTokensOptions) (string, error) {
+	if t.IsZero() {
+		return "", fmt.Errorf("cannot format: %w", ErrZeroTime)
+	}
+	if pattern == "" {
+		return "", fmt.Errorf("cannot format: %w", ErrEmptyFormat)
+	}
+	if options == nil {
+		options = &FormatTokensOptions{}
+	}
+
+	if options.Timezone != nil {
+		t = t.In(options.Timezone)
+	}
+
+	tokens, err := tokenizePattern(pattern, options.UseAdditionalWeekYearTokens, options.UseAdditionalDayOfYearTokens)
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 0, len(pattern)+16)
+	return string(appendTokens(buf, t, tokens, options)), nil
+}
+
+// appendTokens appends the formatted tokens to dst.
+func appendTokens(dst []byte, t time.Time, tokens []patternToken, options *FormatTokensOptions) []byte {
+	numbering := options.numberingSystem()
+	for _, tok := range tokens {
+		if tok.letter == 0 {
+			dst = append(dst, tok.literal...)
+			continue
+		}
+		start := len(dst)
+		dst = appendToken(dst, t, tok, options)
+		dst = numbering.localize(dst, start)
+	}
+	return dst
+}
+
+// ordinalUnits maps the letters of ordinal tokens to their unit.
+var ordinalUnits = map[byte]OrdinalUnit{
+	'y': OrdinalYear, 'Y': OrdinalYear, 'Q': OrdinalQuarter, 'q': OrdinalQuarter,
+	'M': OrdinalMonth, 'L': OrdinalMonth, 'w': OrdinalWeek, 'I': OrdinalWeek,
+	'd': OrdinalDate, 'D': OrdinalDayOfYear, 'e': OrdinalDay, 'c': OrdinalDay, 'i': OrdinalDay,
+	'h': OrdinalHour, 'H': OrdinalHour, 'K': OrdinalHour, 'k': OrdinalHour,
+	'm': OrdinalMinute, 's': OrdinalSecond,
+}
+
+// appendNumberToken appends a numeric field honoring the ordinal and
+// spelled-out modifiers and padding.
+func appendNumberToken(dst []byte, value int, tok patternToken, locale *Locale) []byte {
+	switch tok.modifier {
+	case 'o':
+		return append(dst, locale.ordinal(value, ordinalUnits[tok.letter])...)
+	case 'n', 'N':
+		if words := locale.spell(value, ordinalUnits[tok.letter], tok.modifier == 'N'); words != "" {
+			return append(dst, words...)
+		}
+		if tok.modifier == 'N' {
+			return append(dst, locale.ordinal(value, ordinalUnits[tok.letter])...)
+		}
+		return appendInt(dst, value, 1)
+	}
+	return appendInt(dst, value, tok.length)
+}
+
+// appendToken appends a single non-literal token to dst.
+func appendToken(dst []byte, t time.Time, tok patternToken, options *FormatTokensOptions) []byte {
+	n := tok.length
+	locale := options.locale()
+
+	switch tok.letter {
+	case 'G':
+		era := 1
+		if t.Year() <= 0 {
+			era = 0
+		}
+		switch {
+		case n <= 3:
+			return append(dst, locale.ErasAbbreviated[era]...)
+		case n == 4:
+			return append(dst, locale.ErasWide[era]...)
+		default:
+			return append(dst, locale.ErasNarrow[era]...)
+		}
+
+	case 'y', 'Y':
+		year := t.Year()
+		if tok.letter == 'Y' {
+			weekStartsOn, firstWeekContainsDate := options.weekRule()
+			year, _ = localWeekYear(t, weekStartsOn, firstWeekContainsDate)
+		}
+		if year <= 0 {
+			year = 1 - year
+		}
+		if n == 2 && tok.modifier == 0 {
+			return appendInt(dst, year%100, 2)
+		}
+		return appendNumberToken(dst, year, tok, locale)
+
+	case 'R':
+		year, _ := t.ISOWeek()
+		return appendInt(dst, year, n)
+
+	case 'u':
+		return appendInt(dst, t.Year(), n)
+
+	case 'Q', 'q':
+		quarter := GetQuarter(t)
+		switch {
+		case n <= 2:
+			return appendNumberToken(dst, quarter, tok, locale)
+		case n == 3:
+			return append(dst, locale.QuartersAbbreviated[quarter-1]...)
+		case n == 4:
+			return append(dst, locale.QuartersWide[quarter-1]...)
+		default:
+			return appendInt(dst, quarter, 1)
+		}
+
+	case 'M', 'L':
+		month := int(t.Month())
+		switch {
+		case n <= 2:
+			return appendNumberToken(dst, month, tok, locale)
+		case n == 3:
+			return append(dst, locale.MonthsAbbreviated[month-1]...)
+		case n == 4:
+			if tok.letter == 'L' && locale.MonthsWideStandalone[month-1] != "" {
+				return append(dst, locale.MonthsWideStandalone[month-1]...)
+			}
+			return append(dst, locale.MonthsWide[month-1]...)
+		default:
+			return append(dst, locale.MonthsNarrow[month-1]...)
+		}
+
+	case 'w':
+		weekStartsOn, firstWeekContainsDate := options.weekRule()
+		_, week := localWeekYear(t, weekStartsOn, firstWeekContainsDate)
+		return appendNumberToken(dst, week, tok, locale)
+
+	case 'I':
+		_, week := t.ISOWeek()
+		return appendNumberToken(dst, week, tok, locale)
+
+	case 'd':
+		return appendNumberToken(dst, t.Day(), tok, locale)
+
+	case 'D':
+		return appendNumberToken(dst, t.YearDay(), tok, locale)
+
+	case 'E':
+		return appendWeekdayName(dst, t.Weekday(), n, locale)
+
+	case 'e', 'c':
+		if n <= 2 {
+			weekStartsOn, _ := options.weekRule()
+			local := (int(t.Weekday())-int(weekStartsOn)+7)%7 + 1
+			return appendNumberToken(dst, local, tok, locale)
+		}
+		return appendWeekdayName(dst, t.Weekday(), n, locale)
+
+	case 'i':
+		if n <= 2 {
+			iso := int(t.Weekday())
+			if iso == 0 {
+				iso = 7
+			}
+			return appendNumberToken(dst, iso, tok, locale)
+		}
+		return appendWeekdayName(dst, t.Weekday(), n, locale)
+
+	case 'a':
+		pm := t.Hour() >= 12
+		return append(dst, locale.meridiem(pm, n)...)
+
+	case 'b':
+		periods, narrow := locale.DayPeriods, locale.DayPeriodsNarrow
+		switch t.Hour() {
+		case 12:
+			if n == 5 && narrow.Noon != "" {
+				return append(dst, narrow.Noon...)
+			}
+			return append(dst, periods.Noon...)
+		case 0:
+			if n == 5 && narrow.Midnight != "" {
+				return append(dst, narrow.Midnight...)
+			}
+			return append(dst, periods.Midnight...)
+		}
+		return append(dst, locale.meridiem(t.Hour() >= 12, n)...)
+
+	case 'B':
+		hour := t.Hour()
+		switch {
+		case hour >= 17:
+			return append(dst, locale.DayPeriods.Evening...)
+		case hour >= 12:
+			return append(dst, locale.DayPeriods.Afternoon...)
+		case hour >= 4:
+			return append(dst, locale.DayPeriods.Morning...)
+		default:
+			return append(dst, locale.DayPeriods.Night...)
+		}
+
+	case 'h':
+		hour := t.Hour() % 12
+		if hour == 0 {
+			hour = 12
+		}
+		return appendNumberToken(dst, hour, tok, locale)
+
+	case 'H':
+		return appendNumberToken(dst, t.Hour(), tok, locale)
+
+	case 'K':
+		return appendNumberToken(dst, t.Hour()%12, tok, locale)
+
+	case 'k':
+		hour := t.Hour()
+		if hour == 0 {
+			hour = 24
+		}
+		return appendNumberToken(dst, hour, tok, locale)
+
+	case 'm':
+		return appendNumberToken(dst, t.Minute(), tok, locale)
+
+	case 's':
+		return appendNumberToken(dst, t.Second(), tok, locale)
+
+	case 'S':
+		return appendFraction(dst, t.Nanosecond(), n)
+
+	case 'X', 'x':
+		_, offset := t.Zone()
+		if offset == 0 && tok.letter == 'X' {
+			return append(dst, 'Z')
+		}
+		switch n {
+		case 1:
+			return appendOffset(dst, offset, "", true)
+		case 2, 4:
+			return appendOffset(dst, offset, "", false)
+		default:
+			return appendOffset(dst, offset, ":", false)
+		}
+
+	case 'O', 'z':
+		_, offset := t.Zone()
+		dst = append(dst, "GMT"...)
+		if n == 4 {
+			return appendOffset(dst, offset, ":", false)
+		}
+		return appendShortOffset(dst, offset)
+
+	case 't':
+		return strconv.AppendInt(dst, t.Unix(), 10)
+
+	case 'T':
+		return strconv.AppendInt(dst, t.UnixMilli(), 10)
+
+	case 'P', 'p':
+		return appendLongFormat(dst, t, tok, options)
+	}
+
+	return dst
+}
+
+// appendWeekdayName appends the weekday name for the E/e/c/i token family.
+// Lengths up to 3 are abbreviated, 4 is wide, 5 is narrow and 6 is short.
+func appendWeekdayName(dst []byte, weekday time.Weekday, n int, locale *Locale) []byte {
+	switch {
+	case n <= 3:
+		return append(dst, locale.WeekdaysAbbreviated[weekday]...)
+	case n == 4:
+		return append(dst, locale.WeekdaysWide[weekday]...)
+	case n == 5:
+		return append(dst, locale.WeekdaysNarrow[weekday]...)
+	default:
+		return append(dst, locale.WeekdaysShort[weekday]...)
+	}
+}
+
+// appendLongFormat expands the localized P/p tokens and formats the result.
+func appendLongFormat(dst []byte, t time.Time, tok patternToken, options *FormatTokensOptions) []byte {
+	tokens, err := tokenizePattern(longFormatPattern(tok, options.locale()), true, true)
+	if err != nil {
+		return dst
+	}
+	return appendTokens(dst, t, tokens, options)
+}
+
+// longFormatPattern returns the pattern a P, p or Pp token stands for in the locale.
+func longFormatPattern(tok patternToken, locale *Locale) string {
+	switch {
+	case tok.letter == 'p':
+		return locale.TimeFormats[min(tok.length, 4)-1]
+	case tok.aux == 0:
+		return locale.DateFormats[min(tok.length, 4)-1]
+	default:
+		return strings.NewReplacer(
+			"{{date}}", locale.DateFormats[min(tok.length, 4)-1],
+			"{{time}}", locale.TimeFormats[min(tok.aux, 4)-1],
+		).Replace(locale.DateTimeFormats[min(tok.length, 4)-1])
+	}
+}
+
+// appendInt appends value padded with leading zeros to at least width digits.
+// Negative values are prefixed with a minus sign before the padding.
+func appendInt(dst []byte, value, width int) []byte {
+	u := uint64(value)
+	if value < 0 {
+		dst = append(dst, '-')
+		u = uint64(-value)
+	}
+
+	var digits [20]byte
+	i := len(digits)
+	for u >= 10 {
+		i--
+		digits[i] = byte('0' + u%10)
+		u /= 10
+	}
+	i--
+	digits[i] = byte('0' + u)
+
+	for w := len(digits) - i; w < width; w++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, digits[i:]...)
+}
+
+// appendFraction appends the first n digits of the fractional second.
+func appendFraction(dst []byte, nanos, n int) []byte {
+	var digits [9]byte
+	for i := 8; i >= 0; i-- {
+		digits[i] = byte('0' + nanos%10)
+		nanos /= 10
+	}
+	if n <= 9 {
+		return append(dst, digits[:n]...)
+	}
+	dst = append(dst, digits[:]...)
+	for i := 9; i < n; i++ {
+		dst = append(dst, '0')
+	}
+	return dst
+}
+
+// appendOffset appends a zone offset as ±hh[sep]mm. When optionalMinutes is set
+// the minutes are omitted for whole-hour offsets.
+func appendOffset(dst []byte, offset int, sep string, optionalMinutes bool) []byte {
+	sign := byte('+')
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	hours := offset / 3600
+	minutes := offset % 3600 / 60
+
+	dst = appendInt(append(dst, sign), hours, 2)
+	if optionalMinutes && minutes == 0 {
+		return dst
+	}
+	return appendInt(append(dst, sep...), minutes, 2)
+}
+
+// appendShortOffset appends a zone offset as ±h[:mm], as used by GMT-8 and GMT+5:30.
+func appendShortOffset(dst []byte, offset int) []byte {
+	sign := byte('+')
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	dst = appendInt(append(dst, sign), offset/3600, 1)
+	if minutes := offset % 3600 / 60; minutes != 0 {
+		dst = appendInt(append(dst, ':'), minutes, 2)
+	}
+	return dst
+}
+
+// localWeekYear returns the local week-numbering year and week of year for t,
+// following date-fns getWeekYear and getWeek.
+func localWeekYear(t time.Time, weekStartsOn time.Weekday, firstWeekContainsDate int) (int, int) {
+	if firstWeekContainsDate < 1 || firstWeekContainsDate > 7 {
+		firstWeekContainsDate = 1
+	}
+
+	day := civilDay(t.Year(), t.Month(), t.Day())
+	year := t.Year()
+
+	firstWeekStart := func(y int) int {
+		anchor := civilDay(y, time.January, firstWeekContainsDate)
+		return anchor - weekdayOffset(anchor, weekStartsOn)
+	}
+
+	start := firstWeekStart(year)
+	if next := firstWeekStart(year + 1); day >= next {
+		year++
+		start = next
+	} else if day < start {
+		year--
+		start = firstWeekStart(year)
+	}
+
+	return year, (day-start)/7 + 1
+}
+
+// civilDay returns the number of days since the Unix epoch for the given calendar date.
+func civilDay(year int, month time.Month, day int) int {
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// weekdayOffset returns how many days after the week start the given civil day falls.
+func weekdayOffset(day int, weekStartsOn time.Weekday) int {
+	// 1970-01-01 was a Thursday
+	weekday := ((day+4)%7 + 7) % 7
+	return (weekday - int(weekStartsOn) + 7) % 7
+}
+
+// englishOrdinal returns n with its English ordinal suffix (1st, 2nd, 3rd, 4th).
+func englishOrdinal(n int) string {
+	suffix := "th"
+	rem100 := n % 100
+	if rem100 < 0 {
+		rem100 = -rem100
+	}
+	if rem100 < 11 || rem100 > 13 {
+		switch rem100 % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return strconv.Itoa(n) + suffix
+}
@@ -0,0 +1,129 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFormatTokens(t *testing.T) {
+	ist := time.FixedZone("IST", 5*3600+30*60)
+	pst := time.FixedZone("PST", -8*3600)
+	testTime := time.Date(2024, time.March, 21, 14, 5, 9, 123456789, time.UTC)
+	monday := time.Monday
+
+	tests := []struct {
+		name    string
+		time    time.Time
+		pattern string
+		options *FormatTokensOptions
+		want    string
+	}{
+		{name: "ISO date", time: testTime, pattern: "yyyy-MM-dd", want: "2024-03-21"},
+		{name: "Time with fraction", time: testTime, pattern: "HH:mm:ss.SSS", want: "14:05:09.123"},
+		{name: "Nanosecond fraction", time: testTime, pattern: "s.SSSSSSSSS", want: "9.123456789"},
+		{name: "Quoted literal", time: testTime, pattern: "'Comment:' yyyy", want: "Comment: 2024"},
+		{name: "Escaped quote", time: testTime, pattern: "h 'o''clock'", want: "2 o'clock"},
+		{name: "Bare double quote", time: testTime, pattern: "''yy''", want: "'24'"},
+		{name: "Unterminated quote", time: testTime, pattern: "yyyy 'rest", want: "2024 rest"},
+		{name: "Ordinal day", time: testTime, pattern: "do MMMM", want: "21st March"},
+		{name: "Ordinal teens", time: time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC), pattern: "do", want: "12th"},
+		{name: "Ordinal month and quarter", time: testTime, pattern: "Mo Qo", want: "3rd 1st"},
+		{name: "Weekday names", time: testTime, pattern: "EEE EEEE EEEEE EEEEEE", want: "Thu Thursday T Th"},
+		{name: "Month names", time: testTime, pattern: "MMM MMMM MMMMM", want: "Mar March M"},
+		{name: "Stand-alone month", time: testTime, pattern: "LLLL", want: "March"},
+		{name: "Quarters", time: testTime, pattern: "Q QQ QQQ QQQQ", want: "1 01 Q1 1st quarter"},
+		{name: "ISO week and week-year", time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), pattern: "RRRR-'W'II-i", want: "2020-W53-5"},
+		{name: "Local week", time: time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC), pattern: "w", want: "1"},
+		{name: "Local week with Monday start", time: time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC), pattern: "w",
+			options: &FormatTokensOptions{WeekStartsOn: &monday, FirstWeekContainsDate: 4}, want: "52"},
+		{name: "Local week-year", time: time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC), pattern: "YYYY",
+			options: &FormatTokensOptions{UseAdditionalWeekYearTokens: true}, want: "2025"},
+		{name: "Day of year", time: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC), pattern: "DDD Do", want: "036 36th"},
+		{name: "Day of year opt-in", time: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC), pattern: "D",
+			options: &FormatTokensOptions{UseAdditionalDayOfYearTokens: true}, want: "36"},
+		{name: "Local day of week", time: testTime, pattern: "e eo cccc",
+			options: &FormatTokensOptions{WeekStartsOn: &monday}, want: "4 4th Thursday"},
+		{name: "ISO day of week", time: time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC), pattern: "i ii iii", want: "7 07 Sun"},
+		{name: "Meridiems", time: testTime, pattern: "a|aa|aaa|aaaa|aaaaa", want: "PM|PM|pm|p.m.|p"},
+		{name: "Noon", time: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), pattern: "b", want: "noon"},
+		{name: "Day period", time: time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC), pattern: "h B", want: "2 at night"},
+		{name: "Hour variants", time: time.Date(2024, 1, 1, 0, 7, 0, 0, time.UTC), pattern: "h hh H HH K k kk", want: "12 12 0 00 0 24 24"},
+		{name: "Era", time: testTime, pattern: "G GGGG GGGGG", want: "AD Anno Domini A"},
+		{name: "Era before common era", time: time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), pattern: "y G", want: "44 BC"},
+		{name: "Two-digit year", time: time.Date(2005, 1, 1, 0, 0, 0, 0, time.UTC), pattern: "yy", want: "05"},
+		{name: "Extended negative year", time: time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), pattern: "uuuu", want: "-0043"},
+		{name: "Offset UTC", time: testTime, pattern: "X XXX xxx", want: "Z Z +00:00"},
+		{name: "Offset half hour", time: testTime.In(ist), pattern: "X XX XXX O OOOO", want: "+0530 +0530 +05:30 GMT+5:30 GMT+05:30"},
+		{name: "Offset negative", time: testTime.In(pst), pattern: "X xxx z zzzz", want: "-08 -08:00 GMT-8 GMT-08:00"},
+		{name: "Timestamps", time: testTime, pattern: "t T", want: "1711029909 1711029909123"},
+		{name: "Localized date", time: testTime, pattern: "P", want: "03/21/2024"},
+		{name: "Localized long date", time: testTime, pattern: "PPPP", want: "Thursday, March 21st, 2024"},
+		{name: "Localized date and time", time: testTime, pattern: "PPpp", want: "Mar 21, 2024, 2:05:09 PM"},
+		{name: "Localized long date and time", time: testTime, pattern: "PPPp", want: "March 21st, 2024 at 2:05 PM"},
+		{name: "With timezone", time: testTime, pattern: "HH:mm xxx",
+			options: &FormatTokensOptions{Timezone: ist}, want: "19:35 +05:30"},
+		{name: "Non-latin literals", time: testTime, pattern: "yyyy年M月d日", want: "2024年3月21日"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatTokens(tt.time, tt.pattern, tt.options)
+			if err != nil {
+				t.Fatalf("FormatTokens() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatTokens() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatTokensErrors(t *testing.T) {
+	testTime := time.Date(2024, time.March, 21, 14, 5, 9, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		time       time.Time
+		pattern    string
+		wantErr    error
+		wantOffset int
+		wantToken  string
+	}{
+		{name: "Unknown token", time: testTime, pattern: "yyyy-MM-dd Comment", wantErr: ErrUnknownToken, wantOffset: 11, wantToken: "C"},
+		{name: "Orphan ordinal suffix", time: testTime, pattern: "MMo", wantErr: ErrUnknownToken, wantOffset: 2, wantToken: "o"},
+		{name: "Week-year without opt-in", time: testTime, pattern: "YYYY-MM-dd", wantErr: ErrAmbiguousToken, wantOffset: 0, wantToken: "YYYY"},
+		{name: "Day of year without opt-in", time: testTime, pattern: "yyyy-MM-DD", wantErr: ErrAmbiguousToken, wantOffset: 8, wantToken: "DD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FormatTokens(tt.time, tt.pattern, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FormatTokens() error = %v, want %v", err, tt.wantErr)
+			}
+			var tokenErr *TokenError
+			if !errors.As(err, &tokenErr) {
+				t.Fatalf("FormatTokens() error is not a *TokenError: %T", err)
+			}
+			if tokenErr.Offset != tt.wantOffset || tokenErr.Token != tt.wantToken {
+				t.Errorf("TokenError = {%q at %d}, want {%q at %d}", tokenErr.Token, tokenErr.Offset, tt.wantToken, tt.wantOffset)
+			}
+		})
+	}
+
+	if _, err := FormatTokens(time.Time{}, "yyyy", nil); err == nil {
+		t.Error("FormatTokens() with zero time should return an error")
+	}
+	if _, err := FormatTokens(testTime, "", nil); err == nil {
+		t.Error("FormatTokens() with empty pattern should return an error")
+	}
+}
+
+func BenchmarkFormatTokens(b *testing.B) {
+	date := time.Date(2024, time.March, 21, 14, 5, 9, 0, time.UTC)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = FormatTokens(date, "EEEE, MMMM do yyyy 'at' h:mm a", nil)
+	}
+}
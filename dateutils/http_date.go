@@ -0,0 +1,260 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The three HTTP-date forms of RFC 9110 section 5.6.7 (formerly RFC 7231
+// section 7.1.1.1), as Go layouts.
+const (
+	httpIMFFixdate = "Mon, 02 Jan 2006 15:04:05 GMT"
+	httpRFC850     = "Monday, 02-Jan-06 15:04:05 GMT"
+	httpAsctime    = time.ANSIC
+)
+
+// ParseHTTPDate parses an HTTP-date, as used by the Date, Last-Modified,
+// If-Modified-Since, Expires and Retry-After headers. All three forms that
+// RFC 9110 requires recipients to accept are recognized:
+//   - IMF-fixdate, the preferred form: "Sun, 06 Nov 1994 08:49:37 GMT";
+//   - the obsolete RFC 850 form: "Sunday, 06-Nov-94 08:49:37 GMT";
+//   - the obsolete asctime form: "Sun Nov  6 08:49:37 1994".
+//
+// As the specification requires, names are case-sensitive, the zone must be
+// GMT and the day of the week must match the date. A two-digit RFC 850 year
+// that would be more than 50 years in the future is read as the most recent
+// past year with the same last two digits. Leading and trailing spaces and
+// tabs are ignored. The result is in UTC.
+// Returns a *ParseError listing the attempted forms if none matches, or an
+// error wrapping ErrEmptyInput for an empty string.
+//
+// Example:
+//
+//	ParseHTTPDate("Sun, 06 Nov 1994 08:49:37 GMT")  // 1994-11-06 08:49:37 UTC
+//	ParseHTTPDate("Sunday, 06-Nov-94 08:49:37 GMT") // 1994-11-06 08:49:37 UTC
+//	ParseHTTPDate("Sun Nov  6 08:49:37 1994")       // 1994-11-06 08:49:37 UTC
+func ParseHTTPDate(s string) (time.Time, error) {
+	return parseHTTPDate(s, time.Now())
+}
+
+// parseHTTPDate is ParseHTTPDate with the current time used to window
+// two-digit years.
+func parseHTTPDate(s string, now time.Time) (time.Time, error) {
+	value := strings.TrimLeft(s, " \t")
+	lead := len(s) - len(value)
+	value = strings.TrimRight(value, " \t")
+	if value == "" {
+		return time.Time{}, fmt.Errorf("cannot parse date string: %w", ErrEmptyInput)
+	}
+
+	forms := []struct {
+		layout string
+		read   func(*tokenInput) (time.Time, string)
+	}{
+		{httpIMFFixdate, readIMFFixdate},
+		{httpRFC850, func(in *tokenInput) (time.Time, string) { return readRFC850Date(in, now) }},
+		{httpAsctime, readAsctimeDate},
+	}
+	attempts := make([]LayoutAttempt, 0, len(forms))
+	for _, form := range forms {
+		in := &tokenInput{s: value}
+		t, reason := form.read(in)
+		if reason == "" && in.pos < len(value) {
+			reason = "unexpected text after the date"
+		}
+		if reason == "" {
+			return t, nil
+		}
+		attempts = append(attempts, LayoutAttempt{Layout: form.layout, Offset: lead + in.pos, Err: errors.New(reason)})
+	}
+	return time.Time{}, newParseError(s, attempts)
+}
+
+// FormatHTTPDate formats a time as an IMF-fixdate in GMT, the only form
+// that RFC 9110 allows senders to generate: "Sun, 06 Nov 1994 08:49:37 GMT".
+// The time is converted to UTC first.
+// Returns an error wrapping ErrZeroTime for the zero time, or an error if
+// the year is outside 0000-9999.
+//
+// Example:
+//
+//	FormatHTTPDate(time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC)) // "Sun, 06 Nov 1994 08:49:37 GMT"
+func FormatHTTPDate(t time.Time) (string, error) {
+	if t.IsZero() {
+		return "", fmt.Errorf("cannot format: %w", ErrZeroTime)
+	}
+	t = t.UTC()
+	if t.Year() < 0 || t.Year() > 9999 {
+		return "", fmt.Errorf("cannot format year %d as an HTTP-date", t.Year())
+	}
+	return t.Format(httpIMFFixdate), nil
+}
+
+// ParseRetryAfter parses the value of a Retry-After header, which RFC 9110
+// section 10.2.3 defines as either an HTTP-date or a number of seconds to
+// wait (delay-seconds), and returns the time after which to retry. Delays
+// are added to now; dates are returned as parsed, in UTC, even if they are
+// already past.
+// Returns a *ParseError if the value is neither form, or an error wrapping
+// ErrEmptyInput for an empty string.
+//
+// Example:
+//
+//	ParseRetryAfter("120", now)                           // now + 2 minutes
+//	ParseRetryAfter("Fri, 31 Dec 1999 23:59:59 GMT", now) // 1999-12-31 23:59:59 UTC
+func ParseRetryAfter(value string, now time.Time) (time.Time, error) {
+	trimmed := strings.Trim(value, " \t")
+	if trimmed != "" && strings.IndexFunc(trimmed, func(r rune) bool { return r < '0' || r > '9' }) < 0 {
+		seconds, err := strconv.ParseInt(trimmed, 10, 64)
+		if err != nil || seconds > math.MaxInt64/int64(time.Second) {
+			return time.Time{}, newParseError(value, []LayoutAttempt{{Layout: "delay-seconds", Err: errors.New("delay is too large")}})
+		}
+		return now.Add(time.Duration(seconds) * time.Second), nil
+	}
+	return parseHTTPDate(value, now)
+}
+
+// readIMFFixdate reads "Sun, 06 Nov 1994 08:49:37 GMT".
+func readIMFFixdate(in *tokenInput) (time.Time, string) {
+	weekday, ok := in.exactName(LocaleEnUS.WeekdaysAbbreviated[:])
+	if !ok {
+		return time.Time{}, "expected a day name such as Sun"
+	}
+	if !in.literal(", ") {
+		return time.Time{}, `expected ", " after the day name`
+	}
+	day, ok := in.digits(2, 2)
+	if !ok {
+		return time.Time{}, "expected a two-digit day"
+	}
+	if !in.literal(" ") {
+		return time.Time{}, "expected a space after the day"
+	}
+	month, ok := in.exactName(LocaleEnUS.MonthsAbbreviated[:])
+	if !ok {
+		return time.Time{}, "expected a month name such as Nov"
+	}
+	if !in.literal(" ") {
+		return time.Time{}, "expected a space after the month"
+	}
+	year, ok := in.digits(4, 4)
+	if !ok {
+		return time.Time{}, "expected a four-digit year"
+	}
+	if !in.literal(" ") {
+		return time.Time{}, "expected a space after the year"
+	}
+	return finishHTTPDate(in, year, month, day, weekday)
+}
+
+// readRFC850Date reads "Sunday, 06-Nov-94 08:49:37 GMT".
+func readRFC850Date(in *tokenInput, now time.Time) (time.Time, string) {
+	weekday, ok := in.exactName(LocaleEnUS.WeekdaysWide[:])
+	if !ok {
+		return time.Time{}, "expected a day name such as Sunday"
+	}
+	if !in.literal(", ") {
+		return time.Time{}, `expected ", " after the day name`
+	}
+	day, ok := in.digits(2, 2)
+	if !ok {
+		return time.Time{}, "expected a two-digit day"
+	}
+	if !in.literal("-") {
+		return time.Time{}, `expected "-" after the day`
+	}
+	month, ok := in.exactName(LocaleEnUS.MonthsAbbreviated[:])
+	if !ok {
+		return time.Time{}, "expected a month name such as Nov"
+	}
+	if !in.literal("-") {
+		return time.Time{}, `expected "-" after the month`
+	}
+	yy, ok := in.digits(2, 2)
+	if !ok {
+		return time.Time{}, "expected a two-digit year"
+	}
+	if !in.literal(" ") {
+		return time.Time{}, "expected a space after the year"
+	}
+	year := now.Year() - now.Year()%100 + yy
+	if year > now.Year()+50 {
+		year -= 100
+	}
+	return finishHTTPDate(in, year, month, day, weekday)
+}
+
+// readAsctimeDate reads "Sun Nov  6 08:49:37 1994".
+func readAsctimeDate(in *tokenInput) (time.Time, string) {
+	weekday, ok := in.exactName(LocaleEnUS.WeekdaysAbbreviated[:])
+	if !ok {
+		return time.Time{}, "expected a day name such as Sun"
+	}
+	if !in.literal(" ") {
+		return time.Time{}, "expected a space after the day name"
+	}
+	month, ok := in.exactName(LocaleEnUS.MonthsAbbreviated[:])
+	if !ok {
+		return time.Time{}, "expected a month name such as Nov"
+	}
+	if !in.literal(" ") {
+		return time.Time{}, "expected a space after the month"
+	}
+	// The day is two digits, or a space and one digit.
+	var day int
+	if in.literal(" ") {
+		day, ok = in.digits(1, 1)
+	} else {
+		day, ok = in.digits(2, 2)
+	}
+	if !ok {
+		return time.Time{}, `expected the day as "06" or " 6"`
+	}
+	if !in.literal(" ") {
+		return time.Time{}, "expected a space after the day"
+	}
+	hour, minute, second, reason := readRFCClock(in, false)
+	if reason != "" {
+		return time.Time{}, reason
+	}
+	if !in.literal(" ") {
+		return time.Time{}, "expected a space after the time"
+	}
+	year, ok := in.digits(4, 4)
+	if !ok {
+		return time.Time{}, "expected a four-digit year"
+	}
+	return rfcDate(year, month+1, day, hour, minute, second, weekday, time.UTC)
+}
+
+// finishHTTPDate reads the "08:49:37 GMT" that ends the IMF-fixdate and
+// RFC 850 forms and builds the date.
+func finishHTTPDate(in *tokenInput, year, month, day, weekday int) (time.Time, string) {
+	hour, minute, second, reason := readRFCClock(in, false)
+	if reason != "" {
+		return time.Time{}, reason
+	}
+	if !in.literal(" GMT") {
+		return time.Time{}, `expected " GMT" after the time`
+	}
+	return rfcDate(year, month+1, day, hour, minute, second, weekday, time.UTC)
+}
+
+// exactName is name with case-sensitive matching.
+func (in *tokenInput) exactName(names []string) (int, bool) {
+	best, bestLen := -1, 0
+	for i, name := range names {
+		if len(name) > bestLen && strings.HasPrefix(in.rest(), name) {
+			best, bestLen = i, len(name)
+		}
+	}
+	if best < 0 {
+		return 0, false
+	}
+	in.pos += bestLen
+	return best, true
+}
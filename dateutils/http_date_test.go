@@ -0,0 +1,154 @@
+package dateutils
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseHTTPDate(t *testing.T) {
+	want := time.Date(1994, time.November, 6, 8, 49, 37, 0, time.UTC)
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"IMF-fixdate", "Sun, 06 Nov 1994 08:49:37 GMT"},
+		{"RFC 850", "Sunday, 06-Nov-94 08:49:37 GMT"},
+		{"asctime", "Sun Nov  6 08:49:37 1994"},
+		{"Optional whitespace", " \tSun, 06 Nov 1994 08:49:37 GMT\t "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseHTTPDate(tt.input)
+			if err != nil {
+				t.Fatalf("ParseHTTPDate(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(want) || got.Location() != time.UTC {
+				t.Errorf("ParseHTTPDate(%q) = %v, want %v", tt.input, got, want)
+			}
+		})
+	}
+}
+
+func TestParseHTTPDateRFC850Years(t *testing.T) {
+	now := time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input string
+		year  int
+	}{
+		{"Thursday, 01-Jan-26 00:00:00 GMT", 2026},
+		{"Wednesday, 01-Jan-76 00:00:00 GMT", 2076},
+		{"Saturday, 01-Jan-77 00:00:00 GMT", 1977},
+		{"Friday, 01-Jan-99 00:00:00 GMT", 1999},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseHTTPDate(tt.input, now)
+			if err != nil {
+				t.Fatalf("parseHTTPDate(%q) error = %v", tt.input, err)
+			}
+			if got.Year() != tt.year {
+				t.Errorf("parseHTTPDate(%q) year = %d, want %d", tt.input, got.Year(), tt.year)
+			}
+		})
+	}
+}
+
+func TestParseHTTPDateErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		reason string
+	}{
+		{"Wrong day of the week", "Mon, 06 Nov 1994 08:49:37 GMT", "is a Sunday, not a Monday"},
+		{"Lower-case names", "sun, 06 nov 1994 08:49:37 gmt", "expected a day name"},
+		{"Zone other than GMT", "Sun, 06 Nov 1994 08:49:37 UTC", `expected " GMT"`},
+		{"Numeric offset", "Sun, 06 Nov 1994 08:49:37 +0000", `expected " GMT"`},
+		{"Missing seconds", "Sun, 06 Nov 1994 08:49 GMT", `expected ":" after the minute`},
+		{"One-digit day", "Sun, 6 Nov 1994 08:49:37 GMT", "expected a two-digit day"},
+		{"Day out of range", "Thu, 31 Nov 1994 08:49:37 GMT", "day 31 out of range"},
+		{"Trailing text", "Sun Nov  6 08:49:37 1994 GMT", "unexpected text"},
+		{"RFC 2822 date", "Sun, 6 Nov 1994 08:49 -0300", "expected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseHTTPDate(tt.input)
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ParseHTTPDate(%q) error = %v, want a *ParseError", tt.input, err)
+			}
+			if len(parseErr.Attempts) != 3 {
+				t.Errorf("ParseHTTPDate(%q) attempts = %d, want 3", tt.input, len(parseErr.Attempts))
+			}
+			if !slices.ContainsFunc(parseErr.Attempts, func(a LayoutAttempt) bool {
+				return strings.Contains(a.Err.Error(), tt.reason)
+			}) {
+				t.Errorf("ParseHTTPDate(%q) attempts = %v, want one to mention %q", tt.input, parseErr.Attempts, tt.reason)
+			}
+		})
+	}
+
+	if _, err := ParseHTTPDate(" "); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("ParseHTTPDate(\" \") error = %v, want ErrEmptyInput", err)
+	}
+}
+
+func TestFormatHTTPDate(t *testing.T) {
+	date := time.Date(1994, time.November, 6, 5, 49, 37, 0, time.FixedZone("BRT", -3*3600))
+	got, err := FormatHTTPDate(date)
+	if err != nil {
+		t.Fatalf("FormatHTTPDate() error = %v", err)
+	}
+	if want := "Sun, 06 Nov 1994 08:49:37 GMT"; got != want {
+		t.Errorf("FormatHTTPDate() = %q, want %q", got, want)
+	}
+	back, err := ParseHTTPDate(got)
+	if err != nil || !back.Equal(date) {
+		t.Errorf("ParseHTTPDate(%q) = %v, %v, want %v", got, back, err, date)
+	}
+
+	if _, err := FormatHTTPDate(time.Time{}); !errors.Is(err, ErrZeroTime) {
+		t.Errorf("FormatHTTPDate(zero) error = %v, want ErrZeroTime", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, time.March, 5, 14, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value string
+		want  time.Time
+	}{
+		{"Delay in seconds", "120", now.Add(2 * time.Minute)},
+		{"Zero delay", " 0 ", now},
+		{"HTTP-date", "Tue, 05 Mar 2024 15:00:00 GMT", time.Date(2024, 3, 5, 15, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRetryAfter(tt.value, now)
+			if err != nil {
+				t.Fatalf("ParseRetryAfter(%q) error = %v", tt.value, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	for _, value := range []string{"-1", "1.5", "99999999999999999999", "soon"} {
+		var parseErr *ParseError
+		if _, err := ParseRetryAfter(value, now); !errors.As(err, &parseErr) {
+			t.Errorf("ParseRetryAfter(%q) error = %v, want a *ParseError", value, err)
+		}
+	}
+}
+
+func BenchmarkParseHTTPDate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseHTTPDate("Sun Nov  6 08:49:37 1994")
+	}
+}
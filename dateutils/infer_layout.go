@@ -0,0 +1,189 @@
+package dateutils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// InferLayoutOptions configures InferLayout.
+type InferLayoutOptions struct {
+	// Layouts is the list of candidate Go reference layouts, in order of
+	// preference for ties. Nil or empty uses CommonDateFormats followed by
+	// the ISO 8601 variants accepted by ParseISO.
+	Layouts []string
+
+	// DateOrder picks the layout of the preferred field order when the
+	// samples cannot tell day-first and month-first layouts apart.
+	// The alternatives are still reported in LayoutGuess.Ambiguous.
+	DateOrder DateOrder
+}
+
+// RejectedSample is a sample that the inferred layout could not parse.
+type RejectedSample struct {
+	Index int    // Position of the sample in the input slice
+	Value string // The sample as given
+	Err   error  // The *ParseError returned for the inferred layout
+}
+
+// LayoutGuess is the result of InferLayout.
+type LayoutGuess struct {
+	Layout     string           // Best layout for the samples
+	Confidence float64          // MatchRate, divided among the Ambiguous readings (0-1)
+	MatchRate  float64          // Fraction of non-blank samples Layout parses (0-1)
+	Rejected   []RejectedSample // Non-blank samples Layout cannot parse
+	Ambiguous  []string         // Layouts that match as many samples with different results
+}
+
+// InferLayout scores every candidate layout against all samples and returns
+// the one that parses the most of them, so a whole column can then be parsed
+// with a single ParseWithFormat layout instead of Parse's per-row search.
+// Samples that only one field order can read, such as "25/03/2024", settle
+// day-first versus month-first; when none do, the other readings are listed
+// in Ambiguous and the confidence is lowered. Blank samples are ignored.
+// Returns an error wrapping ErrEmptyInput if every sample is blank, or the
+// *ParseError of the first sample if no layout parses any of them.
+//
+// Example:
+//
+//	guess, err := InferLayout([]string{"03/04/2024", "25/03/2024"}, nil)
+//	// guess.Layout == "02/01/2006", guess.MatchRate == 1
+func InferLayout(samples []string, options *InferLayoutOptions) (LayoutGuess, error) {
+	if options == nil {
+		options = &InferLayoutOptions{}
+	}
+
+	layouts := options.Layouts
+	if len(layouts) == 0 {
+		layouts = inferenceLayouts()
+	}
+
+	// Index of every non-blank sample, with surrounding spaces trimmed.
+	indexes := make([]int, 0, len(samples))
+	values := make([]string, 0, len(samples))
+	for i, sample := range samples {
+		if value := strings.TrimSpace(sample); value != "" {
+			indexes = append(indexes, i)
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return LayoutGuess{}, fmt.Errorf("cannot infer layout: %w", ErrEmptyInput)
+	}
+
+	scores := make([]layoutScore, len(layouts))
+	best := -1
+	for i, layout := range layouts {
+		scores[i] = scoreLayout(layout, values)
+		if scores[i].matched == 0 {
+			continue
+		}
+		if best < 0 || scores[i].matched > scores[best].matched ||
+			scores[i].matched == scores[best].matched && options.DateOrder != PreferLayoutOrder &&
+				scores[i].info.rank(options.DateOrder) < scores[best].info.rank(options.DateOrder) {
+			best = i
+		}
+	}
+	if best < 0 {
+		attempts := make([]LayoutAttempt, len(layouts))
+		for i := range scores {
+			attempts[i] = layoutAttempt(layouts[i], values[0], scores[i].errs[0])
+		}
+		return LayoutGuess{}, newParseError(values[0], attempts)
+	}
+
+	winner := scores[best]
+	guess := LayoutGuess{
+		Layout:    winner.layout,
+		MatchRate: float64(winner.matched) / float64(len(values)),
+	}
+
+	for i, err := range winner.errs {
+		if err != nil {
+			guess.Rejected = append(guess.Rejected, RejectedSample{
+				Index: indexes[i],
+				Value: samples[indexes[i]],
+				Err:   newParseError(values[i], []LayoutAttempt{layoutAttempt(winner.layout, values[i], err)}),
+			})
+		}
+	}
+
+	// Layouts matching as many samples are only ambiguous when they read
+	// them differently, and each distinct reading is reported once.
+	readings := []layoutScore{winner}
+	for i, score := range scores {
+		if i == best || score.matched != winner.matched {
+			continue
+		}
+		distinct := true
+		for _, reading := range readings {
+			if score.sameReading(reading) {
+				distinct = false
+				break
+			}
+		}
+		if distinct {
+			readings = append(readings, score)
+			guess.Ambiguous = append(guess.Ambiguous, score.layout)
+		}
+	}
+
+	guess.Confidence = guess.MatchRate / float64(len(readings))
+	return guess, nil
+}
+
+// layoutScore records how one layout parsed every sample.
+type layoutScore struct {
+	layout  string
+	info    layoutInfo
+	times   []time.Time // Parsed value per sample, zero when it failed
+	errs    []error     // Parse error per sample, nil when it matched
+	matched int
+}
+
+// scoreLayout parses every value with layout.
+func scoreLayout(layout string, values []string) layoutScore {
+	score := layoutScore{
+		layout: layout,
+		info:   inspectLayout(layout),
+		times:  make([]time.Time, len(values)),
+		errs:   make([]error, len(values)),
+	}
+	for i, value := range values {
+		score.times[i], score.errs[i] = time.Parse(layout, value)
+		if score.errs[i] == nil {
+			score.matched++
+		}
+	}
+	return score
+}
+
+// sameReading reports whether two layouts match the same samples with the
+// same results.
+func (s layoutScore) sameReading(other layoutScore) bool {
+	for i := range s.times {
+		if (s.errs[i] == nil) != (other.errs[i] == nil) || !s.times[i].Equal(other.times[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// inferenceLayouts returns CommonDateFormats followed by the ISO 8601
+// variants it does not already contain.
+func inferenceLayouts() []string {
+	layouts := append([]string(nil), CommonDateFormats...)
+	for _, iso := range isoLayouts {
+		found := false
+		for _, layout := range layouts {
+			if layout == iso {
+				found = true
+				break
+			}
+		}
+		if !found {
+			layouts = append(layouts, iso)
+		}
+	}
+	return layouts
+}
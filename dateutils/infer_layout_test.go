@@ -0,0 +1,131 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestInferLayout(t *testing.T) {
+	tests := []struct {
+		name           string
+		samples        []string
+		options        *InferLayoutOptions
+		wantLayout     string
+		wantMatchRate  float64
+		wantConfidence float64
+		wantRejected   []int
+		wantAmbiguous  []string
+	}{
+		{
+			name:          "ISO dates",
+			samples:       []string{"2024-03-21", "2024-12-01", "2023-01-31"},
+			wantLayout:    "2006-01-02",
+			wantMatchRate: 1, wantConfidence: 1,
+		},
+		{
+			name:          "RFC 3339 variants read the same",
+			samples:       []string{"2024-03-21T10:00:00Z", "2024-03-21T10:00:00+02:00"},
+			wantLayout:    time.RFC3339,
+			wantMatchRate: 1, wantConfidence: 1,
+		},
+		{
+			name:          "Day above twelve proves DMY",
+			samples:       []string{"03/04/2024", "01/02/2024", "25/03/2024"},
+			wantLayout:    "02/01/2006",
+			wantMatchRate: 1, wantConfidence: 1,
+		},
+		{
+			name:          "Day above twelve proves MDY",
+			samples:       []string{"03-04-2024", "12-31-2024"},
+			wantLayout:    "01-02-2006",
+			wantMatchRate: 1, wantConfidence: 1,
+		},
+		{
+			name:          "Undecided day and month",
+			samples:       []string{"03/04/2024", "01/02/2024"},
+			wantLayout:    "01/02/2006",
+			wantMatchRate: 1, wantConfidence: 0.5,
+			wantAmbiguous: []string{"02/01/2006"},
+		},
+		{
+			name:          "Preference picks the layout",
+			samples:       []string{"03/04/2024", "01/02/2024"},
+			options:       &InferLayoutOptions{DateOrder: PreferDMY},
+			wantLayout:    "02/01/2006",
+			wantMatchRate: 1, wantConfidence: 0.5,
+			wantAmbiguous: []string{"01/02/2006"},
+		},
+		{
+			name:          "Rejected rows and blanks",
+			samples:       []string{"21/03/2024", "", "22/03/2024", "n/a", "  23/03/2024 ", "2024-03-24"},
+			wantLayout:    "02/01/2006",
+			wantMatchRate: 0.6, wantConfidence: 0.6,
+			wantRejected: []int{3, 5},
+		},
+		{
+			name:          "Custom layouts",
+			samples:       []string{"21.03.2024", "01.12.2023"},
+			options:       &InferLayoutOptions{Layouts: []string{"2006.01.02", "02.01.2006"}},
+			wantLayout:    "02.01.2006",
+			wantMatchRate: 1, wantConfidence: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guess, err := InferLayout(tt.samples, tt.options)
+			if err != nil {
+				t.Fatalf("InferLayout() unexpected error = %v", err)
+			}
+			if guess.Layout != tt.wantLayout {
+				t.Errorf("Layout = %q, want %q", guess.Layout, tt.wantLayout)
+			}
+			if guess.MatchRate != tt.wantMatchRate || guess.Confidence != tt.wantConfidence {
+				t.Errorf("MatchRate, Confidence = %v, %v, want %v, %v", guess.MatchRate, guess.Confidence, tt.wantMatchRate, tt.wantConfidence)
+			}
+			if len(guess.Rejected) != len(tt.wantRejected) {
+				t.Fatalf("Rejected = %+v, want indexes %v", guess.Rejected, tt.wantRejected)
+			}
+			for i, rejected := range guess.Rejected {
+				if rejected.Index != tt.wantRejected[i] || rejected.Value != tt.samples[rejected.Index] {
+					t.Errorf("Rejected[%d] = %+v, want index %d", i, rejected, tt.wantRejected[i])
+				}
+				var parseErr *ParseError
+				if !errors.As(rejected.Err, &parseErr) {
+					t.Errorf("Rejected[%d].Err = %v, want *ParseError", i, rejected.Err)
+				}
+			}
+			if fmt.Sprint(guess.Ambiguous) != fmt.Sprint(tt.wantAmbiguous) {
+				t.Errorf("Ambiguous = %v, want %v", guess.Ambiguous, tt.wantAmbiguous)
+			}
+		})
+	}
+}
+
+func TestInferLayoutErrors(t *testing.T) {
+	if _, err := InferLayout([]string{"", "  "}, nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("InferLayout() of blank samples error = %v, want wrapped ErrEmptyInput", err)
+	}
+	if _, err := InferLayout(nil, nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("InferLayout(nil) error = %v, want wrapped ErrEmptyInput", err)
+	}
+
+	_, err := InferLayout([]string{"soon", "later"}, nil)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Input != "soon" {
+		t.Errorf("InferLayout() of unparsable samples error = %v, want *ParseError for \"soon\"", err)
+	}
+}
+
+func BenchmarkInferLayout(b *testing.B) {
+	samples := make([]string, 1000)
+	for i := range samples {
+		samples[i] = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, i).Format("02/01/2006")
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = InferLayout(samples, nil)
+	}
+}
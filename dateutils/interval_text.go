@@ -0,0 +1,328 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// defaultIntervalSeparator joins the two ends of a range for locales
+// without an IntervalSeparator.
+const defaultIntervalSeparator = " – "
+
+// FormatInterval writes an interval as a range, like
+// Intl.DateTimeFormat.formatRange, printing the fields both ends share only
+// once: "Jan 3 – 5, 2024", "Jan 30 – Feb 2, 2024" or "Jan 3, 2024, 10:00 – 11:30 AM".
+// Both ends are written with the locale's date pattern for the style and,
+// unless the interval is all-day, its short time pattern. End is inclusive,
+// as in IsWithinInterval: an interval is all-day when it starts at midnight
+// and ends at the last instant of a day (EndOfDay), which is the end
+// ParseIntervalText gives dates without a time, while an End at midnight is
+// that instant and is shown with its time.
+// Ranges of whole days are only collapsed when the pattern spells the month
+// out, so numeric dates are written in full on both ends, as are ranges
+// across years and timed ranges across days. The End is shown in the
+// location of the Start, and the ends are joined with the locale's
+// IntervalSeparator. A nil locale means LocaleEnUS.
+// Returns an error wrapping ErrZeroTime if either end is the zero time, or
+// ErrInvalidInterval if the interval ends before it starts.
+//
+// Example:
+//
+//	day := func(d int) time.Time { return time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC) }
+//	FormatInterval(Interval{Start: day(3), End: EndOfDay(day(5))}, DateStyleMedium, nil)      // "Jan 3 – 5, 2024"
+//	FormatInterval(Interval{Start: day(3), End: EndOfDay(day(5))}, DateStyleLong, LocalePtBR) // "3 – 5 de janeiro de 2024"
+//	FormatInterval(Interval{Start: day(3).Add(10 * time.Hour), End: day(3).Add(11*time.Hour + 30*time.Minute)}, DateStyleMedium, nil)
+//	// "Jan 3, 2024, 10:00 – 11:30 AM"
+func FormatInterval(interval Interval, style DateStyle, locale *Locale) (string, error) {
+	if interval.Start.IsZero() || interval.End.IsZero() {
+		return "", fmt.Errorf("cannot format interval: %w", ErrZeroTime)
+	}
+	if style < DateStyleShort || style > DateStyleFull {
+		return "", fmt.Errorf("invalid date style %d", style)
+	}
+	if interval.End.Before(interval.Start) {
+		return "", fmt.Errorf("cannot format interval: %w", ErrInvalidInterval)
+	}
+	if locale == nil {
+		locale = LocaleEnUS
+	}
+
+	start := interval.Start
+	end := interval.End.In(start.Location())
+	timed := !start.Equal(StartOfDay(start)) || !end.Equal(EndOfDay(end))
+
+	pattern := locale.DateFormats[style-1]
+	if timed {
+		pattern = strings.NewReplacer(
+			"{{date}}", pattern,
+			"{{time}}", locale.TimeFormats[0],
+		).Replace(locale.DateTimeFormats[style-1])
+	}
+	tokens, err := tokenizePattern(pattern, true, true)
+	if err != nil {
+		return "", err
+	}
+
+	options := &FormatTokensOptions{Locale: locale}
+	startTexts := tokenTexts(start, tokens, options)
+	endTexts := tokenTexts(end, tokens, options)
+
+	// The largest field that differs decides what both ends repeat.
+	greatest := -1
+	for i, tok := range tokens {
+		if tok.letter != 0 && startTexts[i] != endTexts[i] {
+			greatest = max(greatest, intervalFieldLevel(tok.letter))
+		}
+	}
+	if greatest < 0 {
+		return strings.Join(startTexts, ""), nil
+	}
+
+	separator := locale.IntervalSeparator
+	if separator == "" {
+		separator = defaultIntervalSeparator
+	}
+	if !canCollapseInterval(tokens, greatest, timed) {
+		return strings.Join(startTexts, "") + separator + strings.Join(endTexts, ""), nil
+	}
+
+	// The start is written up to its last differing field and the end from
+	// its first, so fields before and after the differing ones appear once.
+	first, last := -1, -1
+	for i, tok := range tokens {
+		if tok.letter == 0 || intervalFieldLevel(tok.letter) > greatest {
+			continue
+		}
+		// Day periods and zones that both ends share are written once, at the end.
+		if strings.IndexByte("abBzOXx", tok.letter) >= 0 && startTexts[i] == endTexts[i] {
+			continue
+		}
+		if first < 0 {
+			first = i
+		}
+		last = i
+	}
+	return strings.Join(startTexts[:last+1], "") + separator + strings.Join(endTexts[first:], ""), nil
+}
+
+// intervalFieldLevel ranks the field of a token letter: 3 for years and eras,
+// 2 for quarters and months, 1 for days and weeks and 0 for the time of day.
+func intervalFieldLevel(letter byte) int {
+	switch letter {
+	case 'G', 'y', 'Y', 'R', 'u':
+		return 3
+	case 'Q', 'q', 'M', 'L':
+		return 2
+	case 'd', 'D', 'E', 'e', 'c', 'i', 'w', 'I':
+		return 1
+	}
+	return 0
+}
+
+// canCollapseInterval reports whether the ends can share the fields coarser
+// than the greatest difference. Numeric dates such as "01/03 – 05/2024"
+// would be unreadable, so only patterns with a month name are collapsed.
+func canCollapseInterval(tokens []patternToken, greatest int, timed bool) bool {
+	switch {
+	case greatest == 0:
+		return true
+	case timed || greatest == 3:
+		return false
+	}
+	for _, tok := range tokens {
+		if (tok.letter == 'M' || tok.letter == 'L') && tok.length >= 3 {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenTexts formats each token separately, keeping literals as they are.
+func tokenTexts(t time.Time, tokens []patternToken, options *FormatTokensOptions) []string {
+	numbering := options.numberingSystem()
+	texts := make([]string, len(tokens))
+	for i, tok := range tokens {
+		if tok.letter == 0 {
+			texts[i] = tok.literal
+			continue
+		}
+		texts[i] = string(numbering.localize(appendToken(nil, t, tok, options), 0))
+	}
+	return texts
+}
+
+// intervalTextLayout is the layout name reported in ParseIntervalText errors.
+const intervalTextLayout = "interval text"
+
+// intervalSeparators are the range separators ParseIntervalText splits at,
+// in the order they are looked for.
+var intervalSeparators = []string{"..", "–", "—", " - ", " to ", " until ", " through ", " thru "}
+
+// intervalTextLayouts are the written dates ParseIntervalText reads, after
+// commas and ordinal suffixes are removed, with the precision of each.
+var intervalTextLayouts = []struct {
+	layout    string
+	precision Precision
+}{
+	{"Jan 2 2006", PrecisionDay},
+	{"January 2 2006", PrecisionDay},
+	{"2 Jan 2006", PrecisionDay},
+	{"2 January 2006", PrecisionDay},
+	{"Jan 2006", PrecisionMonth},
+	{"January 2006", PrecisionMonth},
+	{"Jan 2 2006 15:04", PrecisionMinute},
+	{"Jan 2 2006 3:04 PM", PrecisionMinute},
+	{"Jan 2 2006 3:04PM", PrecisionMinute},
+	{"January 2 2006 15:04", PrecisionMinute},
+	{"January 2 2006 3:04 PM", PrecisionMinute},
+	{"2 Jan 2006 15:04", PrecisionMinute},
+	{"2 January 2006 15:04", PrecisionMinute},
+}
+
+// ParseIntervalText parses an interval written by hand or by FormatInterval,
+// such as "2024-01-01..2024-01-31", "Jan 3 – 5, 2024", "Jan 30 – Feb 2, 2024"
+// or "Jan–Mar 2024". ISO 8601 intervals ("2024-01-01/P1M") are parsed as
+// ParseISOInterval does, except that an explicit end without a time is
+// extended like the other forms, so "2024-01-01/2024-01-31" and
+// "2024-01-01..2024-01-31" are the same interval; ends computed from a
+// duration are kept. Otherwise the text is split at the first "..", "–", "—",
+// " - ", " to ", " until ", " through " or " thru ", and an end that is
+// incomplete on its own borrows the missing words from the other end.
+// Each end is an ISO 8601 date, an English written date ("Jan 2, 2006",
+// "2 January 2006", "March 2024", optionally with a time) or anything Parse
+// accepts. An end without a time is extended to the end of its day, month
+// or year, so "Jan–Mar 2024" ends on March 31 at 23:59:59.999999999. As in
+// FormatInterval, the end is inclusive, so the result formats back to the
+// same range.
+// Inputs without an offset are read as UTC.
+// If timezone is provided, the result will be converted to that timezone.
+// Returns a *ParseError if the text is not an interval or ends before it
+// starts, an error wrapping ErrInvalidInterval for a reversed ISO 8601
+// interval, or an error wrapping ErrEmptyInput for an empty string.
+//
+// Example:
+//
+//	interval, _ := ParseIntervalText("Jan 3 – 5, 2024", nil)
+//	// Interval{Start: 2024-01-03 00:00, End: 2024-01-05 23:59:59.999999999}
+func ParseIntervalText(s string, timezone *time.Location) (Interval, error) {
+	if s == "" {
+		return Interval{}, fmt.Errorf("cannot parse interval: %w", ErrEmptyInput)
+	}
+	if timezone == nil {
+		timezone = time.UTC
+	}
+	if parts, err := parseIntervalParts(s, 0); err == nil {
+		if parts.hasStart && parts.hasEnd {
+			parts.end = extendToPrecision(parts.end, parts.endPrecision)
+		}
+		interval := parts.interval()
+		if interval.End.Before(interval.Start) {
+			return Interval{}, fmt.Errorf("cannot parse interval %q: %w", s, ErrInvalidInterval)
+		}
+		return Interval{Start: interval.Start.In(timezone), End: interval.End.In(timezone)}, nil
+	}
+
+	fail := func(offset int, err error) (Interval, error) {
+		return Interval{}, newParseError(s, []LayoutAttempt{{Layout: intervalTextLayout, Offset: originalOffset(s, offset), Err: err}})
+	}
+
+	text := NormalizeDigits(s)
+	lower := strings.ToLower(text)
+	split, width := -1, 0
+	for _, separator := range intervalSeparators {
+		if i := strings.Index(lower, separator); i > 0 {
+			split, width = i, len(separator)
+			break
+		}
+	}
+	if split < 0 {
+		return fail(0, errors.New("expected a range separator such as \"..\" or \"–\""))
+	}
+
+	left := intervalWords(text[:split])
+	right := intervalWords(text[split+width:])
+	if len(left) == 0 || len(right) == 0 {
+		return fail(split, errors.New("expected a date on both sides of the separator"))
+	}
+
+	// The end may lack leading words ("5, 2024" after "Jan 3") and the start
+	// trailing ones ("Jan" before "Mar 2024").
+	var end time.Time
+	var endWords []string
+	for i := 0; i < len(left) && endWords == nil; i++ {
+		words := append(append([]string{}, left[:i]...), right...)
+		if t, precision, ok := parseIntervalEnd(strings.Join(words, " ")); ok {
+			end, endWords = extendToPrecision(t, precision), words
+		}
+	}
+	if endWords == nil {
+		return fail(split+width, errors.New("cannot read the end of the interval"))
+	}
+
+	// The start is tried as written, then with ever longer tails of the end.
+	var start time.Time
+	found := false
+	for j := len(endWords); j > 0 && !found; j-- {
+		words := append(append([]string{}, left...), endWords[j:]...)
+		start, _, found = parseIntervalEnd(strings.Join(words, " "))
+	}
+	if !found {
+		return fail(0, errors.New("cannot read the start of the interval"))
+	}
+	if end.Before(start) {
+		return fail(split, errors.New("interval ends before it starts"))
+	}
+	return Interval{Start: start.In(timezone), End: end.In(timezone)}, nil
+}
+
+// intervalWords splits one side of a written interval into words, dropping
+// commas and ordinal suffixes ("2nd" is read as "2").
+func intervalWords(s string) []string {
+	words := strings.Fields(strings.ReplaceAll(s, ",", " "))
+	for i, word := range words {
+		if len(word) > 2 && isDigit(word[0]) && isDigit(word[len(word)-3]) {
+			switch strings.ToLower(word[len(word)-2:]) {
+			case "st", "nd", "rd", "th":
+				words[i] = word[:len(word)-2]
+			}
+		}
+	}
+	return words
+}
+
+// parseIntervalEnd reads one end of a written interval and its precision.
+func parseIntervalEnd(s string) (time.Time, Precision, bool) {
+	if result, err := ParseISODetailed(s, nil); err == nil && result.Time.Year() != 0 {
+		return result.Time, result.Precision, true
+	}
+	for _, layout := range intervalTextLayouts {
+		if t, err := time.Parse(layout.layout, s); err == nil {
+			return t, layout.precision, true
+		}
+	}
+	if t, err := defaultParser.Parse(s); err == nil {
+		if strings.Contains(s, ":") {
+			return t, PrecisionSecond, true
+		}
+		return t, PrecisionDay, true
+	}
+	return time.Time{}, 0, false
+}
+
+// extendToPrecision moves a time to the end of the day, week, month or year
+// it stands for; times with a clock are kept as they are.
+func extendToPrecision(t time.Time, precision Precision) time.Time {
+	switch precision {
+	case PrecisionYear:
+		return EndOfYear(t)
+	case PrecisionMonth:
+		return EndOfMonth(t)
+	case PrecisionWeek:
+		return EndOfISOWeek(t)
+	case PrecisionDay:
+		return EndOfDay(t)
+	}
+	return t
+}
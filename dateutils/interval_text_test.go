@@ -0,0 +1,203 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFormatInterval(t *testing.T) {
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		interval Interval
+		style    DateStyle
+		locale   *Locale
+		expected string
+	}{
+		{"same month", Interval{at(1, 3, 0, 0), EndOfDay(at(1, 5, 0, 0))}, DateStyleMedium, nil, "Jan 3 – 5, 2024"},
+		{"across months", Interval{at(1, 30, 0, 0), EndOfDay(at(2, 2, 0, 0))}, DateStyleMedium, nil, "Jan 30 – Feb 2, 2024"},
+		{"same day of different months", Interval{at(1, 3, 0, 0), EndOfDay(at(2, 3, 0, 0))}, DateStyleMedium, nil, "Jan 3 – Feb 3, 2024"},
+		{"across years", Interval{at(12, 30, 0, 0), EndOfDay(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))}, DateStyleMedium, nil, "Dec 30, 2024 – Jan 2, 2025"},
+		{"single day", Interval{at(1, 3, 0, 0), EndOfDay(at(1, 3, 0, 0))}, DateStyleMedium, nil, "Jan 3, 2024"},
+		{"end at midnight is timed", Interval{at(1, 3, 0, 0), at(1, 4, 0, 0)}, DateStyleMedium, nil, "Jan 3, 2024, 12:00 AM – Jan 4, 2024, 12:00 AM"},
+		{"instant at midnight", Interval{at(1, 3, 0, 0), at(1, 3, 0, 0)}, DateStyleMedium, nil, "Jan 3, 2024, 12:00 AM"},
+		{"numeric dates are not collapsed", Interval{at(1, 3, 0, 0), EndOfDay(at(1, 5, 0, 0))}, DateStyleShort, nil, "01/03/2024 – 01/05/2024"},
+		{"long", Interval{at(1, 3, 0, 0), EndOfDay(at(1, 5, 0, 0))}, DateStyleLong, nil, "January 3rd – 5th, 2024"},
+		{"weekday in both ends", Interval{at(1, 3, 0, 0), EndOfDay(at(1, 5, 0, 0))}, DateStyleFull, nil, "Wednesday, January 3rd – Friday, January 5th, 2024"},
+
+		{"same day period", Interval{at(1, 3, 10, 0), at(1, 3, 11, 30)}, DateStyleMedium, nil, "Jan 3, 2024, 10:00 – 11:30 AM"},
+		{"different day periods", Interval{at(1, 3, 10, 0), at(1, 3, 14, 0)}, DateStyleMedium, nil, "Jan 3, 2024, 10:00 AM – 2:00 PM"},
+		{"timed across days", Interval{at(1, 3, 10, 0), at(1, 5, 11, 0)}, DateStyleMedium, nil, "Jan 3, 2024, 10:00 AM – Jan 5, 2024, 11:00 AM"},
+		{"timed numeric", Interval{at(1, 3, 10, 0), at(1, 3, 11, 30)}, DateStyleShort, nil, "01/03/2024, 10:00 – 11:30 AM"},
+		{"from midnight is timed", Interval{at(1, 3, 0, 0), at(1, 3, 9, 0)}, DateStyleMedium, nil, "Jan 3, 2024, 12:00 – 9:00 AM"},
+
+		{"portuguese", Interval{at(1, 3, 0, 0), EndOfDay(at(1, 5, 0, 0))}, DateStyleLong, LocalePtBR, "3 – 5 de janeiro de 2024"},
+		{"portuguese across months", Interval{at(1, 30, 0, 0), EndOfDay(at(2, 2, 0, 0))}, DateStyleLong, LocalePtBR, "30 de janeiro – 2 de fevereiro de 2024"},
+		{"portuguese timed", Interval{at(1, 3, 10, 0), at(1, 3, 14, 0)}, DateStyleLong, LocalePtBR, "3 de janeiro de 2024 às 10:00 – 14:00"},
+		{"german separator", Interval{at(1, 3, 0, 0), EndOfDay(at(1, 5, 0, 0))}, DateStyleLong, LocaleDE, "3.–5. Januar 2024"},
+		{"french", Interval{at(1, 30, 0, 0), EndOfDay(at(2, 2, 0, 0))}, DateStyleLong, LocaleFR, "30 janvier – 2 février 2024"},
+		{"russian literal after the year", Interval{at(1, 3, 0, 0), EndOfDay(at(1, 5, 0, 0))}, DateStyleLong, LocaleRU, "3–5 января 2024 г."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatInterval(tt.interval, tt.style, tt.locale)
+			if err != nil || got != tt.expected {
+				t.Errorf("FormatInterval() = %q, %v, want %q", got, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatIntervalLocation(t *testing.T) {
+	// 22:00 in São Paulo is 01:00 UTC on the next day; both ends are shown in the start's location.
+	saoPaulo := time.FixedZone("BRT", -3*3600)
+	interval := Interval{
+		Start: time.Date(2024, 1, 3, 20, 0, 0, 0, saoPaulo),
+		End:   time.Date(2024, 1, 4, 1, 0, 0, 0, time.UTC),
+	}
+	got, err := FormatInterval(interval, DateStyleMedium, nil)
+	if want := "Jan 3, 2024, 8:00 – 10:00 PM"; err != nil || got != want {
+		t.Errorf("FormatInterval() = %q, %v, want %q", got, err, want)
+	}
+}
+
+func TestFormatIntervalErrors(t *testing.T) {
+	start := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	if _, err := FormatInterval(Interval{Start: start}, DateStyleMedium, nil); !errors.Is(err, ErrZeroTime) {
+		t.Errorf("FormatInterval(zero end) error = %v, want wrapped ErrZeroTime", err)
+	}
+	if _, err := FormatInterval(Interval{Start: start, End: start.AddDate(0, 0, -1)}, DateStyleMedium, nil); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("FormatInterval(reversed) error = %v, want wrapped ErrInvalidInterval", err)
+	}
+	if _, err := FormatInterval(Interval{Start: start, End: start}, DateStyleNone, nil); err == nil {
+		t.Error("FormatInterval(DateStyleNone) error = nil, want an error")
+	}
+}
+
+func TestParseIntervalText(t *testing.T) {
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	endOf := func(t time.Time) time.Time { return EndOfDay(t) }
+
+	tests := []struct {
+		input string
+		start time.Time
+		end   time.Time
+	}{
+		{"2024-01-01..2024-01-31", day(2024, 1, 1), endOf(day(2024, 1, 31))},
+		{"2024-03 .. 2024-05", day(2024, 3, 1), endOf(day(2024, 5, 31))},
+		{"2023..2024", day(2023, 1, 1), endOf(day(2024, 12, 31))},
+		{"2024-01-01/P1M", day(2024, 1, 1), day(2024, 2, 1)},
+		{"2024-01-01/2024-01-31", day(2024, 1, 1), endOf(day(2024, 1, 31))},
+		{"2024-01-01/02-15", day(2024, 1, 1), endOf(day(2024, 2, 15))},
+		{"2024-01-03T10:00Z/12:00", day(2024, 1, 3).Add(10 * time.Hour), day(2024, 1, 3).Add(12 * time.Hour)},
+		{"Jan–Mar 2024", day(2024, 1, 1), endOf(day(2024, 3, 31))},
+		{"Jan 3 – 5, 2024", day(2024, 1, 3), endOf(day(2024, 1, 5))},
+		{"Jan 30 – Feb 2, 2024", day(2024, 1, 30), endOf(day(2024, 2, 2))},
+		{"Dec 30, 2024 – Jan 2, 2025", day(2024, 12, 30), endOf(day(2025, 1, 2))},
+		{"3–5 January 2024", day(2024, 1, 3), endOf(day(2024, 1, 5))},
+		{"March 1st to March 3rd, 2024", day(2024, 3, 1), endOf(day(2024, 3, 3))},
+		{"01/02/2024 - 01/05/2024", day(2024, 1, 2), endOf(day(2024, 1, 5))},
+		{"Jan 3, 2024, 10:00 – 11:30 AM", day(2024, 1, 3).Add(10 * time.Hour), day(2024, 1, 3).Add(11*time.Hour + 30*time.Minute)},
+		{"2024-01-03T10:00Z until 2024-01-03T12:00Z", day(2024, 1, 3).Add(10 * time.Hour), day(2024, 1, 3).Add(12 * time.Hour)},
+		{"٢٠٢٤-٠١-٠١..٢٠٢٤-٠١-٣١", day(2024, 1, 1), endOf(day(2024, 1, 31))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseIntervalText(tt.input, nil)
+			if err != nil {
+				t.Fatalf("ParseIntervalText(%q) error = %v", tt.input, err)
+			}
+			if !got.Start.Equal(tt.start) || !got.End.Equal(tt.end) {
+				t.Errorf("ParseIntervalText(%q) = %v – %v, want %v – %v", tt.input, got.Start, got.End, tt.start, tt.end)
+			}
+		})
+	}
+}
+
+func TestParseIntervalTextRoundTrip(t *testing.T) {
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	intervals := []Interval{
+		{day(2024, 1, 3), EndOfDay(day(2024, 1, 5))},
+		{day(2024, 1, 30), EndOfDay(day(2024, 2, 2))},
+		{day(2024, 12, 30), EndOfDay(day(2025, 1, 2))},
+		{day(2024, 1, 3), day(2024, 1, 4)},
+		{day(2024, 1, 3).Add(10 * time.Hour), day(2024, 1, 3).Add(11*time.Hour + 30*time.Minute)},
+	}
+	for _, interval := range intervals {
+		text, err := FormatInterval(interval, DateStyleMedium, nil)
+		if err != nil {
+			t.Fatalf("FormatInterval(%v) error = %v", interval, err)
+		}
+		got, err := ParseIntervalText(text, nil)
+		if err != nil || !got.Start.Equal(interval.Start) || !got.End.Equal(interval.End) {
+			t.Errorf("ParseIntervalText(%q) = %v, %v, want %v", text, got, err, interval)
+		}
+	}
+}
+
+func TestParseIntervalTextFormatRoundTrip(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Jan 3 – 5, 2024", "Jan 3 – 5, 2024"},
+		{"Jan 30 – Feb 2, 2024", "Jan 30 – Feb 2, 2024"},
+		{"Dec 30, 2024 – Jan 2, 2025", "Dec 30, 2024 – Jan 2, 2025"},
+		{"Jan 3 – Jan 3, 2024", "Jan 3, 2024"},
+		{"Jan 3, 2024, 10:00 – 11:30 AM", "Jan 3, 2024, 10:00 – 11:30 AM"},
+		{"2024-01-01..2024-01-31", "Jan 1 – 31, 2024"},
+		{"2024-01-01/2024-01-31", "Jan 1 – 31, 2024"},
+	}
+	for _, tt := range tests {
+		interval, err := ParseIntervalText(tt.input, nil)
+		if err != nil {
+			t.Fatalf("ParseIntervalText(%q) error = %v", tt.input, err)
+		}
+		if got, err := FormatInterval(interval, DateStyleMedium, nil); err != nil || got != tt.expected {
+			t.Errorf("FormatInterval(ParseIntervalText(%q)) = %q, %v, want %q", tt.input, got, err, tt.expected)
+		}
+	}
+}
+
+func TestParseIntervalTextErrors(t *testing.T) {
+	if _, err := ParseIntervalText("", nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("ParseIntervalText(\"\") error = %v, want wrapped ErrEmptyInput", err)
+	}
+	if _, err := ParseIntervalText("2024-02-01/2024-01-01", nil); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("ParseIntervalText(reversed ISO) error = %v, want wrapped ErrInvalidInterval", err)
+	}
+	for _, input := range []string{"2024-01-01", "2024-01-01..", "Mar 5 – Jan 2, 2024", "soon – later"} {
+		var parseErr *ParseError
+		if _, err := ParseIntervalText(input, nil); !errors.As(err, &parseErr) {
+			t.Errorf("ParseIntervalText(%q) error = %v, want a *ParseError", input, err)
+		}
+	}
+}
+
+func BenchmarkFormatInterval(b *testing.B) {
+	interval := Interval{
+		Start: time.Date(2024, 1, 30, 0, 0, 0, 0, time.UTC),
+		End:   EndOfDay(time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = FormatInterval(interval, DateStyleMedium, nil)
+	}
+}
+
+func BenchmarkParseIntervalText(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseIntervalText("Jan 3 – 5, 2024", nil)
+	}
+}
@@ -0,0 +1,376 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isoIntervalLayout is the layout name reported in ISO 8601 interval errors.
+const isoIntervalLayout = "ISO 8601 interval"
+
+// ParseISOInterval parses an ISO 8601 time interval in any of its forms:
+// - start/end: 2024-01-01T00:00Z/2024-02-01T00:00Z
+// - start/duration: 2024-01-01/P1M
+// - duration/end: P1W/2024-03-01
+// - shortened end, taking the missing leading components and the offset
+// from the start: 2024-01-01/15, 2024-02-15/03-14, 2024-02-15T09:00Z/10:30
+// Durations are applied with AddPeriod and SubPeriod.
+// If timezone is provided, the result will be converted to that timezone.
+// Returns a *ParseError if the string is not a valid interval, an error
+// wrapping ErrInvalidInterval if it ends before it starts, or an error
+// wrapping ErrEmptyInput for an empty string.
+//
+// Example:
+//
+//	interval, _ := ParseISOInterval("2024-01-31/P1M", nil)
+//	// Interval{Start: 2024-01-31, End: 2024-02-29}
+func ParseISOInterval(s string, timezone *time.Location) (Interval, error) {
+	if s == "" {
+		return Interval{}, fmt.Errorf("cannot parse ISO interval: %w", ErrEmptyInput)
+	}
+
+	// If no timezone is provided, use UTC
+	if timezone == nil {
+		timezone = time.UTC
+	}
+
+	parts, err := parseIntervalParts(s, 0)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	interval := parts.interval()
+	if interval.End.Before(interval.Start) {
+		return Interval{}, fmt.Errorf("cannot parse ISO interval %q: %w", s, ErrInvalidInterval)
+	}
+	return Interval{Start: interval.Start.In(timezone), End: interval.End.In(timezone)}, nil
+}
+
+// ISOString formats the interval as an ISO 8601 start/end interval, with
+// both times in RFC 3339 format.
+//
+// Example:
+//
+//	Interval{Start: start, End: end}.ISOString() // "2024-01-01T00:00:00Z/2024-02-01T00:00:00Z"
+func (i Interval) ISOString() string {
+	return i.Start.Format(time.RFC3339Nano) + "/" + i.End.Format(time.RFC3339Nano)
+}
+
+// RepeatingInterval is an ISO 8601 repeating interval such as
+// "R5/2024-01-01T09:00Z/P1D": a number of consecutive intervals of the same
+// Period, anchored at the start of the first one or, for the duration/end
+// form ("R5/P1D/2024-03-01"), at the end of the last one.
+// Each interval is computed from the anchor, so monthly repetitions keep the
+// anchor's day of the month instead of drifting after a short month.
+type RepeatingInterval struct {
+	Repetitions int       // Number of intervals, or -1 when unbounded ("R/...")
+	Anchor      time.Time // Start of the first interval, or end of the last one when FromEnd
+	Period      Period    // Length of each interval
+	FromEnd     bool      // The intervals are anchored at their end
+}
+
+// ParseISORepeatingInterval parses an ISO 8601 repeating interval:
+// "Rn/" followed by an interval in start/end, start/duration or duration/end
+// form, where n is the number of intervals and an omitted n means unbounded.
+// For the start/end form, the Period is the distance between start and end
+// as computed by IntervalToPeriod.
+// If timezone is provided, the anchor will be converted to that timezone.
+// Returns a *ParseError if the string is not a valid repeating interval,
+// or an error wrapping ErrEmptyInput for an empty string.
+//
+// Example:
+//
+//	r, _ := ParseISORepeatingInterval("R5/2024-01-01T09:00Z/P1D", nil)
+//	r.Occurrences(0) // 5 daily intervals starting 2024-01-01T09:00Z
+func ParseISORepeatingInterval(s string, timezone *time.Location) (RepeatingInterval, error) {
+	if s == "" {
+		return RepeatingInterval{}, fmt.Errorf("cannot parse ISO repeating interval: %w", ErrEmptyInput)
+	}
+
+	// If no timezone is provided, use UTC
+	if timezone == nil {
+		timezone = time.UTC
+	}
+
+	fail := func(offset int, err error) (RepeatingInterval, error) {
+		return RepeatingInterval{}, newParseError(s, []LayoutAttempt{{Layout: isoIntervalLayout, Offset: offset, Err: err}})
+	}
+
+	slash := strings.IndexByte(s, '/')
+	if s[0] != 'R' && s[0] != 'r' || slash < 0 {
+		return fail(0, errors.New(`expected "R" followed by "/"`))
+	}
+	repetitions := -1
+	if count := s[1:slash]; count != "" {
+		n, err := strconv.Atoi(count)
+		if err != nil || n < 0 || count[0] == '+' {
+			return fail(1, fmt.Errorf("invalid repetition count %q", count))
+		}
+		repetitions = n
+	}
+
+	parts, err := parseIntervalParts(s, slash+1)
+	if err != nil {
+		return RepeatingInterval{}, err
+	}
+
+	r := RepeatingInterval{Repetitions: repetitions, Anchor: parts.start, Period: parts.period}
+	switch {
+	case parts.hasStart && parts.hasEnd:
+		r.Period = IntervalToPeriod(Interval{Start: parts.start, End: parts.end})
+	case parts.hasEnd:
+		r.Anchor, r.FromEnd = parts.end, true
+	}
+	if !AddPeriod(r.Anchor, r.Period).After(r.Anchor) {
+		return fail(slash+1, errors.New("repeating intervals need a positive duration"))
+	}
+
+	r.Anchor = r.Anchor.In(timezone)
+	return r, nil
+}
+
+// Occurrences returns up to limit intervals in chronological order.
+// A limit of zero or less returns every interval of a bounded repetition and
+// nothing for an unbounded one. For an unbounded repetition anchored at its
+// end, the latest limit intervals are returned.
+func (r RepeatingInterval) Occurrences(limit int) []Interval {
+	total := r.Repetitions
+	count := total
+	if total < 0 || (limit > 0 && limit < count) {
+		count = limit
+	}
+	if count <= 0 {
+		return nil
+	}
+	if total < 0 {
+		total = count
+	}
+
+	occurrences := make([]Interval, count)
+	for k := range occurrences {
+		if r.FromEnd {
+			occurrences[k] = r.at(total - 1 - k)
+		} else {
+			occurrences[k] = r.at(k)
+		}
+	}
+	return occurrences
+}
+
+// Next returns the first interval that starts at or after t, and false if
+// there is none.
+//
+// Example:
+//
+//	r, _ := ParseISORepeatingInterval("R/2024-01-01T09:00Z/P1D", nil)
+//	next, _ := r.Next(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC))
+//	// next.Start == 2024-03-11T09:00Z
+func (r RepeatingInterval) Next(t time.Time) (Interval, bool) {
+	if r.Repetitions == 0 {
+		return Interval{}, false
+	}
+	bounded := r.Repetitions > 0
+	step := AddPeriod(r.Anchor, r.Period).Sub(r.Anchor)
+
+	if !r.FromEnd {
+		// Start from an estimate and walk to the exact index.
+		j := 0
+		if t.After(r.Anchor) {
+			j = max(int(t.Sub(r.Anchor)/step)-1, 0)
+		}
+		for r.at(j).Start.Before(t) {
+			j++
+		}
+		for j > 0 && !r.at(j-1).Start.Before(t) {
+			j--
+		}
+		if bounded && j >= r.Repetitions {
+			return Interval{}, false
+		}
+		return r.at(j), true
+	}
+
+	// Anchored at the end, starts move back as the index grows: find the
+	// largest index whose start is still at or after t.
+	if r.at(0).Start.Before(t) {
+		return Interval{}, false
+	}
+	j := 0
+	if t.Before(r.Anchor) {
+		j = max(int(r.Anchor.Sub(t)/step)-1, 0)
+	}
+	if bounded {
+		j = min(j, r.Repetitions-1)
+	}
+	for (!bounded || j+1 < r.Repetitions) && !r.at(j+1).Start.Before(t) {
+		j++
+	}
+	for r.at(j).Start.Before(t) {
+		j--
+	}
+	return r.at(j), true
+}
+
+// ISOString formats the repeating interval in ISO 8601 form, with the
+// anchor in RFC 3339 format.
+func (r RepeatingInterval) ISOString() string {
+	prefix := "R/"
+	if r.Repetitions >= 0 {
+		prefix = "R" + strconv.Itoa(r.Repetitions) + "/"
+	}
+	anchor := r.Anchor.Format(time.RFC3339Nano)
+	if r.FromEnd {
+		return prefix + FormatISODuration(r.Period) + "/" + anchor
+	}
+	return prefix + anchor + "/" + FormatISODuration(r.Period)
+}
+
+// at returns the interval j periods away from the anchor: forward from the
+// start, or backward from the end when FromEnd.
+func (r RepeatingInterval) at(j int) Interval {
+	if r.FromEnd {
+		return Interval{Start: SubPeriod(r.Anchor, r.Period.times(j+1)), End: SubPeriod(r.Anchor, r.Period.times(j))}
+	}
+	return Interval{Start: AddPeriod(r.Anchor, r.Period.times(j)), End: AddPeriod(r.Anchor, r.Period.times(j+1))}
+}
+
+// intervalParts holds the two halves of an ISO 8601 interval.
+type intervalParts struct {
+	start, end       time.Time
+	endPrecision     Precision // Precision of an explicit end
+	period           Period
+	hasStart, hasEnd bool
+}
+
+// interval resolves the parts to a start and end.
+func (p intervalParts) interval() Interval {
+	switch {
+	case p.hasStart && p.hasEnd:
+		return Interval{Start: p.start, End: p.end}
+	case p.hasStart:
+		return Interval{Start: p.start, End: AddPeriod(p.start, p.period)}
+	default:
+		return Interval{Start: SubPeriod(p.end, p.period), End: p.end}
+	}
+}
+
+// parseIntervalParts parses the interval that begins at offset from in s.
+// Times keep the offset they were written with.
+func parseIntervalParts(s string, from int) (intervalParts, error) {
+	var parts intervalParts
+	fail := func(offset int, err error) (intervalParts, error) {
+		return parts, newParseError(s, []LayoutAttempt{{Layout: isoIntervalLayout, Offset: offset, Err: err}})
+	}
+
+	text := s[from:]
+	slash := strings.IndexByte(text, '/')
+	if slash < 0 {
+		return fail(len(s), errors.New(`expected "/" between the start and end`))
+	}
+	first, second := text[:slash], text[slash+1:]
+	secondAt := from + slash + 1
+	if first == "" {
+		return fail(from, errors.New("missing interval start"))
+	}
+	if second == "" {
+		return fail(secondAt, errors.New("missing interval end"))
+	}
+
+	isDuration := func(part string) bool {
+		return part[0] == 'P' || part[0] == 'p' || (len(part) > 1 && part[0] == '-' && (part[1] == 'P' || part[1] == 'p'))
+	}
+
+	var startResult ISOResult
+	if isDuration(first) {
+		if isDuration(second) {
+			return fail(secondAt, errors.New("an interval cannot have two durations"))
+		}
+		period, offset, err := parsePeriod(first)
+		if err != nil {
+			return fail(from+offset, err)
+		}
+		parts.period = period
+	} else {
+		p := isoParser{input: first}
+		result, err := p.parse()
+		if err != nil {
+			return fail(from+p.pos, err)
+		}
+		if result.Representation == ISOTimeOnly {
+			return fail(from, errors.New("interval start must have a date"))
+		}
+		startResult, parts.start, parts.hasStart = result, result.Time, true
+	}
+
+	if isDuration(second) {
+		period, offset, err := parsePeriod(second)
+		if err != nil {
+			return fail(secondAt+offset, err)
+		}
+		parts.period = period
+		return parts, nil
+	}
+
+	end := second
+	if parts.hasStart {
+		end = completeIntervalEnd(first, startResult, second)
+	}
+	p := isoParser{input: end}
+	result, err := p.parse()
+	if err != nil {
+		// Offsets into a completed end are only meaningful within it.
+		offset := p.pos - (len(end) - len(second))
+		return fail(secondAt+max(offset, 0), err)
+	}
+	if result.Representation == ISOTimeOnly {
+		return fail(secondAt, errors.New("interval end must have a date"))
+	}
+	parts.end, parts.endPrecision, parts.hasEnd = result.Time, result.Precision, true
+	return parts, nil
+}
+
+// completeIntervalEnd fills a shortened interval end with the leading date
+// components of the start and, for an end with a time but no offset, the
+// start's offset. Ends that are already complete are returned unchanged.
+func completeIntervalEnd(startText string, start ISOResult, end string) string {
+	if start.Representation != ISOCalendarDate || start.Basic || start.Precision < PrecisionDay || !isDigit(startText[0]) {
+		return end
+	}
+
+	datePart, timePart, hasTime := end, "", false
+	if i := strings.IndexAny(end, "Tt"); i >= 0 {
+		datePart, timePart, hasTime = end[:i], end[i+1:], true
+	} else if strings.Contains(end, ":") {
+		datePart, timePart, hasTime = "", end, true
+	}
+
+	// A complete date has a year; the shortened forms are DD and MM-DD.
+	fields := strings.Split(datePart, "-")
+	if datePart != "" && (len(fields) > 2 || len(fields[0]) > 2) {
+		return end
+	}
+
+	date := startText[:10]
+	switch {
+	case datePart == "":
+	case len(fields) == 1:
+		date = startText[:8] + datePart
+	default:
+		date = startText[:5] + datePart
+	}
+	if !hasTime {
+		return date
+	}
+
+	if start.HasOffset && !strings.ContainsAny(timePart, "Zz+-") {
+		if name, offset := start.Time.Zone(); offset == 0 && name == "UTC" {
+			timePart += "Z"
+		} else {
+			timePart += start.Time.Format("-07:00")
+		}
+	}
+	return date + "T" + timePart
+}
@@ -0,0 +1,232 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseISOInterval(t *testing.T) {
+	utc := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    Interval
+		wantErr bool
+	}{
+		{name: "Start and end", input: "2024-01-01T00:00Z/2024-02-01T00:00Z",
+			want: Interval{Start: utc(2024, 1, 1, 0, 0), End: utc(2024, 2, 1, 0, 0)}},
+		{name: "Start and duration", input: "2024-01-01/P1M",
+			want: Interval{Start: utc(2024, 1, 1, 0, 0), End: utc(2024, 2, 1, 0, 0)}},
+		{name: "Start and duration clamps month", input: "2024-01-31/P1M",
+			want: Interval{Start: utc(2024, 1, 31, 0, 0), End: utc(2024, 2, 29, 0, 0)}},
+		{name: "Duration and end", input: "P1W/2024-03-01",
+			want: Interval{Start: utc(2024, 2, 23, 0, 0), End: utc(2024, 3, 1, 0, 0)}},
+		{name: "Offsets", input: "2024-01-01T10:00+02:00/PT2H",
+			want: Interval{Start: utc(2024, 1, 1, 8, 0), End: utc(2024, 1, 1, 10, 0)}},
+		{name: "Shortened day", input: "2024-01-01/15",
+			want: Interval{Start: utc(2024, 1, 1, 0, 0), End: utc(2024, 1, 15, 0, 0)}},
+		{name: "Shortened month and day", input: "2024-02-15/03-14",
+			want: Interval{Start: utc(2024, 2, 15, 0, 0), End: utc(2024, 3, 14, 0, 0)}},
+		{name: "Shortened time keeps offset", input: "2024-02-15T09:00+01:00/10:30",
+			want: Interval{Start: utc(2024, 2, 15, 8, 0), End: utc(2024, 2, 15, 9, 30)}},
+		{name: "Shortened day and time", input: "2007-11-13T09:00Z/15T17:00",
+			want: Interval{Start: utc(2007, 11, 13, 9, 0), End: utc(2007, 11, 15, 17, 0)}},
+		{name: "Different representations", input: "2024-W01-1/2024-035",
+			want: Interval{Start: utc(2024, 1, 1, 0, 0), End: utc(2024, 2, 4, 0, 0)}},
+		{name: "Missing slash", input: "2024-01-01", wantErr: true},
+		{name: "Two durations", input: "P1D/P2D", wantErr: true},
+		{name: "Invalid shortened end", input: "2024-01-31/32", wantErr: true},
+		{name: "Invalid duration", input: "2024-01-01/P1X", wantErr: true},
+		{name: "Time-only start", input: "T10:00/T11:00", wantErr: true},
+		{name: "Empty end", input: "2024-01-01/", wantErr: true},
+		{name: "Empty", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseISOInterval(tt.input, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseISOInterval() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (!got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End)) {
+				t.Errorf("ParseISOInterval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var parseErr *ParseError
+	if _, err := ParseISOInterval("2024-01-01/P1X", nil); !errors.As(err, &parseErr) || parseErr.Offset != 13 {
+		t.Errorf("ParseISOInterval() error = %v, want *ParseError at offset 13", err)
+	}
+	if _, err := ParseISOInterval("", nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("ParseISOInterval(\"\") error = %v, want wrapped ErrEmptyInput", err)
+	}
+	for _, input := range []string{"2024-02-01/2024-01-01", "2024-02-01T10:00Z/09:00", "2024-02-01/-P1D"} {
+		if _, err := ParseISOInterval(input, nil); !errors.Is(err, ErrInvalidInterval) {
+			t.Errorf("ParseISOInterval(%q) error = %v, want wrapped ErrInvalidInterval", input, err)
+		}
+	}
+}
+
+func TestIntervalISOString(t *testing.T) {
+	interval := Interval{
+		Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 2, 1, 12, 30, 0, 500000000, time.FixedZone("", 3600)),
+	}
+	want := "2024-01-01T00:00:00Z/2024-02-01T12:30:00.5+01:00"
+	if got := interval.ISOString(); got != want {
+		t.Fatalf("ISOString() = %q, want %q", got, want)
+	}
+	parsed, err := ParseISOInterval(want, nil)
+	if err != nil || !parsed.Start.Equal(interval.Start) || !parsed.End.Equal(interval.End) {
+		t.Errorf("ParseISOInterval(ISOString()) = %v, %v", parsed, err)
+	}
+}
+
+func TestParseISORepeatingInterval(t *testing.T) {
+	day := func(month time.Month, d, hour int) time.Time {
+		return time.Date(2024, month, d, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		input      string
+		want       RepeatingInterval
+		wantStarts []time.Time
+		wantErr    bool
+	}{
+		{name: "Bounded start and duration", input: "R5/2024-01-01T09:00Z/P1D",
+			want:       RepeatingInterval{Repetitions: 5, Anchor: day(1, 1, 9), Period: Period{Days: 1}},
+			wantStarts: []time.Time{day(1, 1, 9), day(1, 2, 9), day(1, 3, 9), day(1, 4, 9), day(1, 5, 9)}},
+		{name: "Start and end", input: "R2/2024-01-31/2024-02-29",
+			want:       RepeatingInterval{Repetitions: 2, Anchor: day(1, 31, 0), Period: Period{Months: 1}},
+			wantStarts: []time.Time{day(1, 31, 0), day(2, 29, 0)}},
+		{name: "Monthly keeps the anchor day", input: "R3/2024-01-31/P1M",
+			want:       RepeatingInterval{Repetitions: 3, Anchor: day(1, 31, 0), Period: Period{Months: 1}},
+			wantStarts: []time.Time{day(1, 31, 0), day(2, 29, 0), day(3, 31, 0)}},
+		{name: "Duration and end", input: "R3/P1W/2024-03-01",
+			want:       RepeatingInterval{Repetitions: 3, Anchor: day(3, 1, 0), Period: Period{Weeks: 1}, FromEnd: true},
+			wantStarts: []time.Time{day(2, 9, 0), day(2, 16, 0), day(2, 23, 0)}},
+		{name: "Unbounded", input: "R/2024-01-01T09:00Z/PT12H",
+			want:       RepeatingInterval{Repetitions: -1, Anchor: day(1, 1, 9), Period: Period{Hours: 12}},
+			wantStarts: []time.Time{day(1, 1, 9), day(1, 1, 21), day(1, 2, 9)}},
+		{name: "Zero repetitions", input: "R0/2024-01-01/P1D",
+			want: RepeatingInterval{Repetitions: 0, Anchor: day(1, 1, 0), Period: Period{Days: 1}}},
+		{name: "Missing R", input: "5/2024-01-01/P1D", wantErr: true},
+		{name: "Invalid count", input: "Rx/2024-01-01/P1D", wantErr: true},
+		{name: "Negative count", input: "R-1/2024-01-01/P1D", wantErr: true},
+		{name: "Zero duration", input: "R/2024-01-01/PT0S", wantErr: true},
+		{name: "Negative duration", input: "R/2024-01-01/-P1D", wantErr: true},
+		{name: "Missing interval", input: "R5/2024-01-01", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseISORepeatingInterval(tt.input, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseISORepeatingInterval() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Repetitions != tt.want.Repetitions || !got.Anchor.Equal(tt.want.Anchor) ||
+				got.Period != tt.want.Period || got.FromEnd != tt.want.FromEnd {
+				t.Errorf("ParseISORepeatingInterval() = %+v, want %+v", got, tt.want)
+			}
+
+			occurrences := got.Occurrences(len(tt.wantStarts))
+			if len(occurrences) != len(tt.wantStarts) {
+				t.Fatalf("Occurrences() returned %d intervals, want %d", len(occurrences), len(tt.wantStarts))
+			}
+			for i, occurrence := range occurrences {
+				if !occurrence.Start.Equal(tt.wantStarts[i]) {
+					t.Errorf("Occurrences()[%d].Start = %v, want %v", i, occurrence.Start, tt.wantStarts[i])
+				}
+				if i > 0 && !occurrences[i-1].End.Equal(occurrence.Start) {
+					t.Errorf("Occurrences()[%d] does not follow the previous interval", i)
+				}
+			}
+
+			again, err := ParseISORepeatingInterval(got.ISOString(), nil)
+			if err != nil || again != got {
+				t.Errorf("ParseISORepeatingInterval(%q) = %+v, %v, want %+v", got.ISOString(), again, err, got)
+			}
+		})
+	}
+}
+
+func TestRepeatingIntervalOccurrencesLimits(t *testing.T) {
+	bounded, _ := ParseISORepeatingInterval("R5/2024-01-01/P1D", nil)
+	if got := bounded.Occurrences(0); len(got) != 5 {
+		t.Errorf("bounded Occurrences(0) returned %d intervals, want 5", len(got))
+	}
+	if got := bounded.Occurrences(10); len(got) != 5 {
+		t.Errorf("bounded Occurrences(10) returned %d intervals, want 5", len(got))
+	}
+
+	unbounded, _ := ParseISORepeatingInterval("R/2024-01-01/P1D", nil)
+	if got := unbounded.Occurrences(0); got != nil {
+		t.Errorf("unbounded Occurrences(0) = %v, want nil", got)
+	}
+
+	backward, _ := ParseISORepeatingInterval("R/P1D/2024-03-01", nil)
+	got := backward.Occurrences(2)
+	if len(got) != 2 || !got[0].Start.Equal(time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC)) ||
+		!got[1].End.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unbounded backward Occurrences(2) = %v", got)
+	}
+}
+
+func TestRepeatingIntervalNext(t *testing.T) {
+	at := func(month time.Month, d, hour int) time.Time {
+		return time.Date(2024, month, d, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		after     time.Time
+		wantStart time.Time
+		wantOK    bool
+	}{
+		{name: "Before the anchor", input: "R5/2024-01-01T09:00Z/P1D", after: at(1, 1, 0), wantStart: at(1, 1, 9), wantOK: true},
+		{name: "On an occurrence", input: "R5/2024-01-01T09:00Z/P1D", after: at(1, 3, 9), wantStart: at(1, 3, 9), wantOK: true},
+		{name: "Between occurrences", input: "R5/2024-01-01T09:00Z/P1D", after: at(1, 3, 10), wantStart: at(1, 4, 9), wantOK: true},
+		{name: "After the last", input: "R5/2024-01-01T09:00Z/P1D", after: at(1, 5, 10), wantOK: false},
+		{name: "Unbounded far ahead", input: "R/2024-01-01T09:00Z/P1D", after: at(12, 10, 12), wantStart: at(12, 11, 9), wantOK: true},
+		{name: "Monthly", input: "R/2024-01-31/P1M", after: at(4, 1, 0), wantStart: at(4, 30, 0), wantOK: true},
+		{name: "From end", input: "R3/P1W/2024-03-01", after: at(2, 10, 0), wantStart: at(2, 16, 0), wantOK: true},
+		{name: "From end before the first", input: "R3/P1W/2024-03-01", after: at(1, 1, 0), wantStart: at(2, 9, 0), wantOK: true},
+		{name: "From end after the last start", input: "R3/P1W/2024-03-01", after: at(2, 24, 0), wantOK: false},
+		{name: "Unbounded from end", input: "R/P1D/2024-03-01", after: at(1, 15, 12), wantStart: at(1, 16, 0), wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseISORepeatingInterval(tt.input, nil)
+			if err != nil {
+				t.Fatalf("ParseISORepeatingInterval() error = %v", err)
+			}
+			got, ok := r.Next(tt.after)
+			if ok != tt.wantOK {
+				t.Fatalf("Next() ok = %v, want %v (%v)", ok, tt.wantOK, got)
+			}
+			if ok && !got.Start.Equal(tt.wantStart) {
+				t.Errorf("Next().Start = %v, want %v", got.Start, tt.wantStart)
+			}
+		})
+	}
+}
+
+func BenchmarkRepeatingIntervalNext(b *testing.B) {
+	r, _ := ParseISORepeatingInterval("R/2024-01-31T09:00Z/P1M", nil)
+	after := time.Date(2030, 6, 15, 0, 0, 0, 0, time.UTC)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = r.Next(after)
+	}
+}
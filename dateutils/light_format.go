@@ -0,0 +1,176 @@
+package dateutils
+
+import (
+	"fmt"
+	"time"
+)
+
+// lightField is a field of a compiled LightFormat pattern.
+type lightField uint8
+
+const (
+	lightLiteral lightField = iota
+	lightYear
+	lightMonth
+	lightDay
+	lightHour
+	lightMinute
+	lightSecond
+	lightMillisecond
+)
+
+// lightTokens lists the LightFormat tokens; at each position the first
+// match wins, so SSS is tried before anything shorter.
+var lightTokens = [...]struct {
+	text  string
+	field lightField
+}{
+	{"YYYY", lightYear},
+	{"SSS", lightMillisecond},
+	{"MM", lightMonth},
+	{"DD", lightDay},
+	{"HH", lightHour},
+	{"mm", lightMinute},
+	{"ss", lightSecond},
+}
+
+// lightElement is a token or a run of literal text.
+type lightElement struct {
+	field   lightField
+	literal string
+}
+
+// Layout is a compiled LightFormat pattern. Compiling once and appending
+// into a reused buffer formats without allocating, which suits hot paths
+// such as logging. A Layout is immutable and safe for concurrent use.
+type Layout struct {
+	pattern   string
+	elements  []lightElement
+	hasDate   bool
+	hasClock  bool
+	numbering NumberingSystem
+}
+
+// CompileLightFormat compiles a LightFormat pattern into a Layout.
+// The tokens are the ones LightFormat supports; all other text is copied as is.
+// Returns an error wrapping ErrEmptyFormat if the pattern is empty.
+//
+// Example:
+//
+//	layout, _ := CompileLightFormat("YYYY-MM-DD HH:mm:ss.SSS")
+//	buf = layout.AppendFormat(buf[:0], time.Now())
+func CompileLightFormat(pattern string) (*Layout, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("cannot compile format: %w", ErrEmptyFormat)
+	}
+
+	layout := &Layout{pattern: pattern}
+	literalStart := 0
+	for i := 0; i < len(pattern); {
+		field, n := matchLightToken(pattern[i:])
+		if n == 0 {
+			i++
+			continue
+		}
+		if literalStart < i {
+			layout.elements = append(layout.elements, lightElement{literal: pattern[literalStart:i]})
+		}
+		layout.elements = append(layout.elements, lightElement{field: field})
+		switch field {
+		case lightYear, lightMonth, lightDay:
+			layout.hasDate = true
+		case lightHour, lightMinute, lightSecond:
+			layout.hasClock = true
+		}
+		i += n
+		literalStart = i
+	}
+	if literalStart < len(pattern) {
+		layout.elements = append(layout.elements, lightElement{literal: pattern[literalStart:]})
+	}
+	return layout, nil
+}
+
+// matchLightToken returns the token at the start of s and its length,
+// or a length of 0 if s does not start with a token.
+func matchLightToken(s string) (lightField, int) {
+	for _, token := range lightTokens {
+		if len(s) >= len(token.text) && s[:len(token.text)] == token.text {
+			return token.field, len(token.text)
+		}
+	}
+	return lightLiteral, 0
+}
+
+// AppendFormat appends the formatted time to dst and returns the extended
+// buffer, like time.Time.AppendFormat. It does not allocate when dst has
+// enough capacity. Years are padded to four digits, with a leading minus
+// sign for years before 1 BC, matching the Go layout 2006.
+//
+// Example:
+//
+//	layout, _ := CompileLightFormat("YYYY-MM-DD")
+//	layout.AppendFormat(nil, time.Date(2019, 2, 11, 0, 0, 0, 0, time.UTC)) // []byte("2019-02-11")
+func (l *Layout) AppendFormat(dst []byte, t time.Time) []byte {
+	var (
+		year              int
+		month             time.Month
+		day               int
+		hour, min, second int
+	)
+	if l.hasDate {
+		year, month, day = t.Date()
+	}
+	if l.hasClock {
+		hour, min, second = t.Clock()
+	}
+
+	for _, element := range l.elements {
+		start := len(dst)
+		switch element.field {
+		case lightLiteral:
+			dst = append(dst, element.literal...)
+		case lightYear:
+			dst = appendInt(dst, year, 4)
+		case lightMonth:
+			dst = appendInt(dst, int(month), 2)
+		case lightDay:
+			dst = appendInt(dst, day, 2)
+		case lightHour:
+			dst = appendInt(dst, hour, 2)
+		case lightMinute:
+			dst = appendInt(dst, min, 2)
+		case lightSecond:
+			dst = appendInt(dst, second, 2)
+		case lightMillisecond:
+			dst = appendInt(dst, t.Nanosecond()/int(time.Millisecond), 3)
+		}
+		if element.field != lightLiteral {
+			dst = l.numbering.localize(dst, start)
+		}
+	}
+	return dst
+}
+
+// WithNumberingSystem returns a copy of the Layout that writes its fields,
+// but not its literal text, in the given NumberingSystem.
+//
+// Example:
+//
+//	layout, _ := CompileLightFormat("YYYY-MM-DD")
+//	layout.WithNumberingSystem(NumberingArabic).Format(time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC)) // "٢٠٢٤-٠٣-٢١"
+func (l *Layout) WithNumberingSystem(ns NumberingSystem) *Layout {
+	copied := *l
+	copied.numbering = ns
+	return &copied
+}
+
+// Format returns the formatted time as a string.
+func (l *Layout) Format(t time.Time) string {
+	return string(l.AppendFormat(make([]byte, 0, len(l.pattern)+8), t))
+}
+
+// String returns the pattern the Layout was compiled from.
+func (l *Layout) String() string {
+	return l.pattern
+}
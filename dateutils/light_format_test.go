@@ -0,0 +1,125 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCompileLightFormat(t *testing.T) {
+	date := time.Date(2019, time.February, 11, 14, 0, 5, 123000000, time.UTC)
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		pattern  string
+		expected string
+	}{
+		{name: "Datetime with milliseconds", date: date, pattern: "YYYY-MM-DD HH:mm:ss.SSS", expected: "2019-02-11 14:00:05.123"},
+		{name: "Repeated tokens", date: date, pattern: "DD/DD", expected: "11/11"},
+		{name: "Longer runs keep the remainder", date: date, pattern: "MMM SSSS", expected: "02M 123S"},
+		{name: "Literal text only", date: date, pattern: "at noon", expected: "at noon"},
+		{name: "Negative year", date: time.Date(-1, time.March, 4, 0, 0, 0, 0, time.UTC), pattern: "YYYY-MM-DD", expected: "-0001-03-04"},
+		{name: "Year zero", date: time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC), pattern: "YYYY", expected: "0000"},
+		{name: "Five-digit year", date: time.Date(12345, time.January, 1, 0, 0, 0, 0, time.UTC), pattern: "YYYY", expected: "12345"},
+		{name: "Time zone of the value", date: date.In(time.FixedZone("", -3*3600)), pattern: "HH:mm", expected: "11:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout, err := CompileLightFormat(tt.pattern)
+			if err != nil {
+				t.Fatalf("CompileLightFormat(%q) error = %v", tt.pattern, err)
+			}
+			if got := string(layout.AppendFormat(nil, tt.date)); got != tt.expected {
+				t.Errorf("AppendFormat() = %q, expected %q", got, tt.expected)
+			}
+			if got := layout.Format(tt.date); got != tt.expected {
+				t.Errorf("Format() = %q, expected %q", got, tt.expected)
+			}
+			if got := LightFormat(tt.date, tt.pattern); got != tt.expected {
+				t.Errorf("LightFormat() = %q, expected %q", got, tt.expected)
+			}
+			if layout.String() != tt.pattern {
+				t.Errorf("String() = %q, expected %q", layout.String(), tt.pattern)
+			}
+		})
+	}
+
+	if _, err := CompileLightFormat(""); !errors.Is(err, ErrEmptyFormat) {
+		t.Errorf("CompileLightFormat(\"\") error = %v, expected ErrEmptyFormat", err)
+	}
+}
+
+func TestLayoutAppendFormatAppends(t *testing.T) {
+	layout, _ := CompileLightFormat("HH:mm")
+	date := time.Date(2024, time.March, 21, 9, 5, 0, 0, time.UTC)
+	if got := string(layout.AppendFormat([]byte("at "), date)); got != "at 09:05" {
+		t.Errorf("AppendFormat() = %q, expected %q", got, "at 09:05")
+	}
+}
+
+func TestLayoutAppendFormatAllocations(t *testing.T) {
+	layout, _ := CompileLightFormat("YYYY-MM-DD HH:mm:ss.SSS")
+	date := time.Date(2019, time.February, 11, 14, 0, 5, 123000000, time.UTC)
+	buf := make([]byte, 0, 64)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = layout.AppendFormat(buf[:0], date)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendFormat() allocated %v times per call, expected 0", allocs)
+	}
+
+	arabic := layout.WithNumberingSystem(NumberingArabic)
+	allocs = testing.AllocsPerRun(100, func() {
+		buf = arabic.AppendFormat(buf[:0], date)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendFormat() with native digits allocated %v times per call, expected 0", allocs)
+	}
+}
+
+func TestLayoutWithNumberingSystem(t *testing.T) {
+	layout, _ := CompileLightFormat("'YYYY' YYYY-MM-DD HH:mm:ss.SSS 42")
+	date := time.Date(2024, time.March, 21, 14, 5, 9, 7000000, time.UTC)
+
+	tests := []struct {
+		ns       NumberingSystem
+		expected string
+	}{
+		{"", "'2024' 2024-03-21 14:05:09.007 42"},
+		{NumberingArabic, "'٢٠٢٤' ٢٠٢٤-٠٣-٢١ ١٤:٠٥:٠٩.٠٠٧ 42"},
+		{NumberingDevanagari, "'२०२४' २०२४-०३-२१ १४:०५:०९.००७ 42"},
+	}
+	for _, tt := range tests {
+		if got := layout.WithNumberingSystem(tt.ns).Format(date); got != tt.expected {
+			t.Errorf("WithNumberingSystem(%q).Format() = %q, want %q", tt.ns, got, tt.expected)
+		}
+	}
+	if got := layout.Format(date); got != tests[0].expected {
+		t.Errorf("WithNumberingSystem() changed the original Layout: %q", got)
+	}
+}
+
+func BenchmarkLayoutAppendFormat(b *testing.B) {
+	layout, _ := CompileLightFormat("YYYY-MM-DD HH:mm:ss.SSS")
+	date := time.Date(2019, time.February, 11, 14, 0, 5, 123000000, time.UTC)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = layout.AppendFormat(buf[:0], date)
+	}
+}
+
+// BenchmarkTimeAppendFormat is the standard library baseline for BenchmarkLayoutAppendFormat.
+func BenchmarkTimeAppendFormat(b *testing.B) {
+	date := time.Date(2019, time.February, 11, 14, 0, 5, 123000000, time.UTC)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = date.AppendFormat(buf[:0], "2006-01-02 15:04:05.000")
+	}
+}
@@ -0,0 +1,532 @@
+package dateutils
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OrdinalUnit is the field an ordinal number refers to. Languages such as
+// French and Portuguese pick the suffix by the grammatical gender of the unit.
+type OrdinalUnit int
+
+const (
+	OrdinalNumber    OrdinalUnit = iota // A plain number with no unit
+	OrdinalYear                         // y, Y
+	OrdinalQuarter                      // Q, q
+	OrdinalMonth                        // M, L
+	OrdinalWeek                         // w, I
+	OrdinalDate                         // d
+	OrdinalDayOfYear                    // D
+	OrdinalDay                          // e, c, i
+	OrdinalHour                         // h, H, K, k
+	OrdinalMinute                       // m
+	OrdinalSecond                       // s
+)
+
+// PluralCategory is a CLDR plural category, which selects the grammatical
+// form of a phrase for a number.
+type PluralCategory int
+
+const (
+	PluralOther PluralCategory = iota // Everything not covered below (English "2 hours")
+	PluralOne                         // English and German 1, Russian 21
+	PluralFew                         // Polish and Russian 2-4, 22-24
+	PluralMany                        // Polish and Russian 5-20, 25-30
+)
+
+// PluralForms holds a phrase in each plural category, with "{{count}}"
+// standing for the number. Empty categories use Other.
+type PluralForms struct {
+	One   string
+	Few   string
+	Many  string
+	Other string
+}
+
+// DistanceTable holds the phrases of FormatDistance and FormatDistanceStrict,
+// one per threshold bucket, named after the date-fns locale keys.
+type DistanceTable struct {
+	LessThanXSeconds PluralForms // less than 5 seconds
+	XSeconds         PluralForms // 30 seconds
+	HalfAMinute      PluralForms // half a minute
+	LessThanXMinutes PluralForms // less than a minute
+	XMinutes         PluralForms // 5 minutes
+	AboutXHours      PluralForms // about 2 hours
+	XHours           PluralForms // 2 hours
+	XDays            PluralForms // 3 days
+	AboutXWeeks      PluralForms // about 2 weeks
+	XWeeks           PluralForms // 2 weeks
+	AboutXMonths     PluralForms // about 1 month
+	XMonths          PluralForms // 3 months
+	AboutXYears      PluralForms // about 1 year
+	XYears           PluralForms // 2 years
+	OverXYears       PluralForms // over 1 year
+	AlmostXYears     PluralForms // almost 2 years
+}
+
+// distanceToken identifies a phrase of a DistanceTable.
+type distanceToken int
+
+const (
+	distanceLessThanXSeconds distanceToken = iota
+	distanceXSeconds
+	distanceHalfAMinute
+	distanceLessThanXMinutes
+	distanceXMinutes
+	distanceAboutXHours
+	distanceXHours
+	distanceXDays
+	distanceAboutXWeeks
+	distanceXWeeks
+	distanceAboutXMonths
+	distanceXMonths
+	distanceAboutXYears
+	distanceXYears
+	distanceOverXYears
+	distanceAlmostXYears
+)
+
+// forms returns the phrase for a token.
+func (d *DistanceTable) forms(token distanceToken) PluralForms {
+	switch token {
+	case distanceLessThanXSeconds:
+		return d.LessThanXSeconds
+	case distanceXSeconds:
+		return d.XSeconds
+	case distanceHalfAMinute:
+		return d.HalfAMinute
+	case distanceLessThanXMinutes:
+		return d.LessThanXMinutes
+	case distanceXMinutes:
+		return d.XMinutes
+	case distanceAboutXHours:
+		return d.AboutXHours
+	case distanceXHours:
+		return d.XHours
+	case distanceXDays:
+		return d.XDays
+	case distanceAboutXWeeks:
+		return d.AboutXWeeks
+	case distanceXWeeks:
+		return d.XWeeks
+	case distanceAboutXMonths:
+		return d.AboutXMonths
+	case distanceXMonths:
+		return d.XMonths
+	case distanceAboutXYears:
+		return d.AboutXYears
+	case distanceXYears:
+		return d.XYears
+	case distanceOverXYears:
+		return d.OverXYears
+	default:
+		return d.AlmostXYears
+	}
+}
+
+// category returns the phrase for a plural category, or "" if it is not set.
+func (f PluralForms) category(c PluralCategory) string {
+	switch c {
+	case PluralOne:
+		return f.One
+	case PluralFew:
+		return f.Few
+	case PluralMany:
+		return f.Many
+	default:
+		return f.Other
+	}
+}
+
+// RelativeTable holds the FormatRelative patterns of a Locale, written in the
+// FormatTokens grammar and chosen by the calendar day of the date relative to
+// the base date. Empty patterns fall back to Other, and an empty Other to "P".
+type RelativeTable struct {
+	LastWeek  string // 2 to 6 days before: "'last' eeee 'at' p"
+	Yesterday string // "'yesterday at' p"
+	Today     string // "'today at' p"
+	Tomorrow  string // "'tomorrow at' p"
+	NextWeek  string // 2 to 6 days after: "eeee 'at' p"
+	Other     string // A week or more away: "P"
+
+	// LastWeekdays and NextWeekdays replace LastWeek and NextWeek for one
+	// weekday, indexed by time.Weekday, for languages where "last" agrees
+	// with the weekday: Portuguese "último domingo", "última segunda-feira".
+	LastWeekdays [7]string
+	NextWeekdays [7]string
+
+	// ThisWeekdays, when set, is used instead for days 2 to 6 days away that
+	// are in the same week as the base date, as in Russian "в среду" versus
+	// "в прошлую среду".
+	ThisWeekdays [7]string
+}
+
+// relativeCategory identifies a pattern of a RelativeTable.
+type relativeCategory int
+
+const (
+	relativeLastWeek relativeCategory = iota
+	relativeYesterday
+	relativeToday
+	relativeTomorrow
+	relativeNextWeek
+	relativeOther
+)
+
+// pattern returns the pattern for a category and the weekday of the date.
+func (r *RelativeTable) pattern(category relativeCategory, weekday time.Weekday, sameWeek bool) string {
+	pattern := ""
+	switch category {
+	case relativeLastWeek, relativeNextWeek:
+		if sameWeek {
+			pattern = r.ThisWeekdays[weekday]
+		}
+		if pattern == "" && category == relativeLastWeek {
+			pattern = r.LastWeekdays[weekday]
+			if pattern == "" {
+				pattern = r.LastWeek
+			}
+		}
+		if pattern == "" && category == relativeNextWeek {
+			pattern = r.NextWeekdays[weekday]
+			if pattern == "" {
+				pattern = r.NextWeek
+			}
+		}
+	case relativeYesterday:
+		pattern = r.Yesterday
+	case relativeToday:
+		pattern = r.Today
+	case relativeTomorrow:
+		pattern = r.Tomorrow
+	}
+	if pattern == "" {
+		pattern = r.Other
+	}
+	if pattern == "" {
+		pattern = "P"
+	}
+	return pattern
+}
+
+// DurationTable holds the unit phrases of one FormatDuration style.
+type DurationTable struct {
+	Years   PluralForms // 2 yrs
+	Months  PluralForms // 2 mths
+	Weeks   PluralForms // 2 wks
+	Days    PluralForms // 2 days
+	Hours   PluralForms // 2 hr
+	Minutes PluralForms // 2 min
+	Seconds PluralForms // 2 sec
+}
+
+// DayPeriods holds the names of the periods of the day used by the b and B
+// format tokens, written as they follow a time ("3:00 in the afternoon").
+type DayPeriods struct {
+	Midnight  string
+	Noon      string
+	Morning   string // 04:00-11:59
+	Afternoon string // 12:00-16:59
+	Evening   string // 17:00-23:59
+	Night     string // 00:00-03:59
+}
+
+// Locale holds the names, ordinal rules and default patterns used to format
+// dates in one language and region. The built-in locales can be copied and
+// modified; a Locale must not be changed while it is in use.
+//
+// Example:
+//
+//	FormatLocale(date, "EEEE, d 'de' MMMM", LocalePtBR) // "quinta-feira, 21 de março"
+type Locale struct {
+	Code string // BCP 47 language tag, such as "pt-BR"
+
+	MonthsWide        [12]string // January
+	MonthsAbbreviated [12]string // Jan
+	MonthsNarrow      [12]string // J
+	// MonthsWideStandalone holds the LLLL names for languages that decline
+	// month names in dates, such as Polish and Russian; empty uses MonthsWide.
+	MonthsWideStandalone [12]string
+
+	WeekdaysWide        [7]string // Sunday, indexed by time.Weekday
+	WeekdaysAbbreviated [7]string // Sun
+	WeekdaysShort       [7]string // Su
+	WeekdaysNarrow      [7]string // S
+
+	MeridiemsWide        [2]string // a.m., p.m.
+	MeridiemsAbbreviated [2]string // AM, PM
+	MeridiemsNarrow      [2]string // a, p
+
+	DayPeriods DayPeriods // Names for the b and B tokens
+	// DayPeriodsNarrow holds the bbbbb names; empty names use DayPeriods.
+	DayPeriodsNarrow DayPeriods
+
+	ErasWide        [2]string // Before Christ, Anno Domini
+	ErasAbbreviated [2]string // BC, AD
+	ErasNarrow      [2]string // B, A
+
+	QuartersWide        [4]string // 1st quarter
+	QuartersAbbreviated [4]string // Q1
+
+	// Ordinal returns n as an ordinal for the given unit, such as "1st" or
+	// "1er". Nil uses the English rules.
+	Ordinal func(n int, unit OrdinalUnit) string
+
+	// SpellOut writes n in words, as a cardinal or an ordinal for the given
+	// unit, or returns "" if it cannot. Nil means the Locale cannot spell
+	// numbers out, and the n and N tokens fall back to digits.
+	SpellOut func(n int, unit OrdinalUnit, ordinal bool) string
+
+	// SpelledDateFormat is the FormatSpelledOut pattern, usually built from
+	// the n and N tokens: "'the' dN 'day of' MMMM, yn".
+	SpelledDateFormat string
+
+	// DateFormats, TimeFormats and DateTimeFormats are the patterns of the
+	// P and p tokens and of FormatStyle, from short to full. DateTimeFormats
+	// combine the two with the {{date}} and {{time}} placeholders.
+	DateFormats     [4]string
+	TimeFormats     [4]string
+	DateTimeFormats [4]string
+
+	// NumberingSystem is the set of digits the token formatter writes.
+	// Empty means NumberingLatin.
+	NumberingSystem NumberingSystem
+
+	WeekStartsOn          time.Weekday // First day of the week
+	FirstWeekContainsDate int          // Day of January always in the first week (1-7)
+
+	// Plural returns the plural category of n. Nil uses the English rule:
+	// PluralOne for 1 and PluralOther for everything else.
+	Plural func(n int) PluralCategory
+
+	// Distance holds the FormatDistance phrases. DistanceWithSuffix holds the
+	// forms some languages use after a preposition, such as German "vor 2
+	// Tagen"; phrases it leaves empty come from Distance.
+	Distance           DistanceTable
+	DistanceWithSuffix DistanceTable
+
+	// DistancePast and DistanceFuture place a distance in time, with
+	// "{{distance}}" standing for the phrase: "{{distance}} ago", "in {{distance}}".
+	DistancePast   string
+	DistanceFuture string
+
+	// Relative holds the FormatRelative patterns.
+	Relative RelativeTable
+
+	// DurationShort and DurationNarrow hold the FormatDuration unit phrases
+	// of the short and narrow styles; the long style uses the X phrases of Distance.
+	DurationShort  DurationTable
+	DurationNarrow DurationTable
+
+	// Conjunction is the word FormatDuration can put before the last unit: "and".
+	Conjunction string
+
+	// IntervalSeparator joins the two ends of a FormatInterval range.
+	// Empty means " – ".
+	IntervalSeparator string
+
+	// Connectors are the words that join the parts of a written date, such
+	// as "de" in "2 de janeiro de 2024". A Parser using the Locale skips them.
+	Connectors []string
+
+	// Natural holds the ParseNatural grammar. An empty grammar means the
+	// Locale does not support ParseNatural.
+	Natural NaturalGrammar
+}
+
+// ordinal formats n with the Locale's ordinal rule.
+func (l *Locale) ordinal(n int, unit OrdinalUnit) string {
+	if l.Ordinal == nil {
+		return englishOrdinal(n)
+	}
+	return l.Ordinal(n, unit)
+}
+
+// plural returns the plural category of n with the Locale's rule.
+func (l *Locale) plural(n int) PluralCategory {
+	if l.Plural == nil {
+		return pluralOneOther(n)
+	}
+	return l.Plural(n)
+}
+
+// count returns the phrase of forms for n, falling back to the Other form.
+func (l *Locale) count(forms PluralForms, n int) string {
+	phrase := forms.category(l.plural(n))
+	if phrase == "" {
+		phrase = forms.Other
+	}
+	return strings.ReplaceAll(phrase, "{{count}}", strconv.Itoa(n))
+}
+
+// distance returns the phrase for a token and count. With a suffix the
+// result is placed in the past or future template.
+func (l *Locale) distance(token distanceToken, count int, addSuffix, future bool) string {
+	base := l.Distance.forms(token)
+	category := l.plural(count)
+
+	phrase := base.category(category)
+	if addSuffix {
+		suffixed := l.DistanceWithSuffix.forms(token)
+		for _, candidate := range []string{suffixed.category(category), phrase, suffixed.Other} {
+			if candidate != "" {
+				phrase = candidate
+				break
+			}
+		}
+	}
+	if phrase == "" {
+		phrase = base.Other
+	}
+	phrase = strings.ReplaceAll(phrase, "{{count}}", strconv.Itoa(count))
+
+	if !addSuffix {
+		return phrase
+	}
+	template := l.DistancePast
+	if future {
+		template = l.DistanceFuture
+	}
+	return strings.ReplaceAll(template, "{{distance}}", phrase)
+}
+
+// meridiem returns the AM/PM name for the a token widths: up to 2 is
+// abbreviated, 3 is lowercase abbreviated, 4 is wide and 5 is narrow.
+func (l *Locale) meridiem(pm bool, n int) string {
+	idx := 0
+	if pm {
+		idx = 1
+	}
+	switch n {
+	case 1, 2:
+		return l.MeridiemsAbbreviated[idx]
+	case 3:
+		return strings.ToLower(l.MeridiemsAbbreviated[idx])
+	case 5:
+		return l.MeridiemsNarrow[idx]
+	default:
+		return l.MeridiemsWide[idx]
+	}
+}
+
+// Locales returns the built-in locales.
+func Locales() []*Locale {
+	return []*Locale{LocaleEnUS, LocaleEnGB, LocalePtBR, LocalePtPT, LocaleES, LocaleFR, LocaleDE, LocaleIT, LocalePL, LocaleRU}
+}
+
+// LookupLocale returns the built-in locale for a language tag such as
+// "pt-BR", "pt_br" or "fr". A tag with an unknown region falls back to the
+// first built-in locale of its language, so "es-MX" returns LocaleES and
+// "en" returns LocaleEnUS.
+//
+// Example:
+//
+//	locale, ok := LookupLocale("de-AT") // LocaleDE, true
+func LookupLocale(code string) (*Locale, bool) {
+	code = strings.ReplaceAll(code, "_", "-")
+	for _, locale := range Locales() {
+		if strings.EqualFold(locale.Code, code) {
+			return locale, true
+		}
+	}
+
+	language, _, _ := strings.Cut(code, "-")
+	for _, locale := range Locales() {
+		base, _, _ := strings.Cut(locale.Code, "-")
+		if strings.EqualFold(base, language) {
+			return locale, true
+		}
+	}
+	return nil, false
+}
+
+// Ordinal rules of the built-in locales.
+
+// suffixOrdinal returns an ordinal rule that appends the same suffix to every number.
+func suffixOrdinal(suffix string) func(int, OrdinalUnit) string {
+	return func(n int, _ OrdinalUnit) string {
+		return strconv.Itoa(n) + suffix
+	}
+}
+
+// portugueseOrdinal uses the feminine "ª" for weeks (semana) and "º" otherwise.
+func portugueseOrdinal(n int, unit OrdinalUnit) string {
+	if unit == OrdinalWeek {
+		return strconv.Itoa(n) + "ª"
+	}
+	return strconv.Itoa(n) + "º"
+}
+
+// frenchOrdinal writes 1er, or 1re for feminine units, and 2e, 3e, ... otherwise.
+func frenchOrdinal(n int, unit OrdinalUnit) string {
+	if n != 1 {
+		return strconv.Itoa(n) + "e"
+	}
+	switch unit {
+	case OrdinalYear, OrdinalWeek, OrdinalHour, OrdinalMinute, OrdinalSecond:
+		return "1re"
+	}
+	return "1er"
+}
+
+// russianOrdinal uses "-е" for dates (число), "-я" for feminine units and "-й" otherwise.
+func russianOrdinal(n int, unit OrdinalUnit) string {
+	switch unit {
+	case OrdinalDate, OrdinalDayOfYear:
+		return strconv.Itoa(n) + "-е"
+	case OrdinalWeek, OrdinalMinute, OrdinalSecond:
+		return strconv.Itoa(n) + "-я"
+	}
+	return strconv.Itoa(n) + "-й"
+}
+
+// Plural rules of the built-in locales, for integers.
+
+// pluralOneOther is the English, German, Spanish, Italian and Portuguese rule.
+func pluralOneOther(n int) PluralCategory {
+	if n == 1 || n == -1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// pluralFrench treats 0 and 1 as singular.
+func pluralFrench(n int) PluralCategory {
+	if n >= -1 && n <= 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// pluralPolish is one for 1, few for 2-4 except 12-14 and many otherwise.
+func pluralPolish(n int) PluralCategory {
+	n = absInt(n)
+	switch {
+	case n == 1:
+		return PluralOne
+	case isFewSlavic(n):
+		return PluralFew
+	default:
+		return PluralMany
+	}
+}
+
+// pluralRussian is one for 1, 21, 31, ... except 11, few for 2-4 except
+// 12-14 and many otherwise.
+func pluralRussian(n int) PluralCategory {
+	n = absInt(n)
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return PluralOne
+	case isFewSlavic(n):
+		return PluralFew
+	default:
+		return PluralMany
+	}
+}
+
+// isFewSlavic reports whether n ends in 2-4 but not in 12-14.
+func isFewSlavic(n int) bool {
+	return n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14)
+}
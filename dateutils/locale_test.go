@@ -0,0 +1,184 @@
+package dateutils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLookupLocale(t *testing.T) {
+	tests := []struct {
+		code   string
+		want   *Locale
+		wantOK bool
+	}{
+		{code: "en-US", want: LocaleEnUS, wantOK: true},
+		{code: "pt_br", want: LocalePtBR, wantOK: true},
+		{code: "PT-PT", want: LocalePtPT, wantOK: true},
+		{code: "en", want: LocaleEnUS, wantOK: true},
+		{code: "es-MX", want: LocaleES, wantOK: true},
+		{code: "de-AT", want: LocaleDE, wantOK: true},
+		{code: "fr", want: LocaleFR, wantOK: true},
+		{code: "it-CH", want: LocaleIT, wantOK: true},
+		{code: "pl-PL", want: LocalePL, wantOK: true},
+		{code: "ru", want: LocaleRU, wantOK: true},
+		{code: "ja-JP", wantOK: false},
+		{code: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got, ok := LookupLocale(tt.code)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("LookupLocale(%q) = %v, %v, want %v, %v", tt.code, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestLocalesComplete(t *testing.T) {
+	seen := map[string]bool{}
+	for _, locale := range Locales() {
+		if seen[locale.Code] {
+			t.Errorf("duplicate locale %q", locale.Code)
+		}
+		seen[locale.Code] = true
+
+		names := [][]string{
+			locale.MonthsWide[:], locale.MonthsAbbreviated[:], locale.MonthsNarrow[:],
+			locale.WeekdaysWide[:], locale.WeekdaysAbbreviated[:], locale.WeekdaysShort[:], locale.WeekdaysNarrow[:],
+			locale.MeridiemsWide[:], locale.MeridiemsAbbreviated[:], locale.MeridiemsNarrow[:],
+			locale.ErasWide[:], locale.ErasAbbreviated[:], locale.ErasNarrow[:],
+			locale.QuartersWide[:], locale.QuartersAbbreviated[:],
+			locale.DateFormats[:], locale.TimeFormats[:], locale.DateTimeFormats[:],
+			{locale.DayPeriods.Midnight, locale.DayPeriods.Noon, locale.DayPeriods.Morning,
+				locale.DayPeriods.Afternoon, locale.DayPeriods.Evening, locale.DayPeriods.Night},
+		}
+		for _, list := range names {
+			for i, name := range list {
+				if name == "" {
+					t.Errorf("locale %q has an empty name at index %d of %v", locale.Code, i, list)
+				}
+			}
+		}
+		for token := distanceLessThanXSeconds; token <= distanceAlmostXYears; token++ {
+			forms := locale.Distance.forms(token)
+			if forms.Other == "" && (forms.One == "" || forms.Many == "") {
+				t.Errorf("locale %q has no fallback phrase for distance token %d", locale.Code, token)
+			}
+		}
+		if locale.DistancePast == "" || locale.DistanceFuture == "" {
+			t.Errorf("locale %q has no distance templates", locale.Code)
+		}
+		for category := relativeLastWeek; category < relativeOther; category++ {
+			for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+				pattern := locale.Relative.pattern(category, weekday, false)
+				if pattern == locale.Relative.Other {
+					t.Errorf("locale %q has no relative pattern %d for %v", locale.Code, category, weekday)
+				}
+				if _, err := FormatLocale(time.Now(), pattern, locale); err != nil {
+					t.Errorf("locale %q relative pattern %q: %v", locale.Code, pattern, err)
+				}
+			}
+		}
+		for _, table := range []DurationTable{locale.DurationShort, locale.DurationNarrow} {
+			for unit, forms := range []PluralForms{table.Years, table.Months, table.Weeks, table.Days, table.Hours, table.Minutes, table.Seconds} {
+				if forms.Other == "" {
+					t.Errorf("locale %q has no duration phrase for unit %d", locale.Code, unit)
+				}
+			}
+		}
+		if locale.Conjunction == "" {
+			t.Errorf("locale %q has no conjunction", locale.Code)
+		}
+		if (locale.SpellOut == nil) != (locale.SpelledDateFormat == "") {
+			t.Errorf("locale %q has only one of SpellOut and SpelledDateFormat", locale.Code)
+		}
+		if locale.SpelledDateFormat != "" {
+			if _, err := FormatSpelledOut(time.Now(), locale); err != nil {
+				t.Errorf("locale %q spelled-out date pattern %q: %v", locale.Code, locale.SpelledDateFormat, err)
+			}
+		}
+		if locale.FirstWeekContainsDate < 1 || locale.FirstWeekContainsDate > 7 {
+			t.Errorf("locale %q has FirstWeekContainsDate %d", locale.Code, locale.FirstWeekContainsDate)
+		}
+	}
+}
+
+func TestLocaleOrdinal(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale *Locale
+		n      int
+		unit   OrdinalUnit
+		want   string
+	}{
+		{name: "English", locale: LocaleEnUS, n: 22, unit: OrdinalDate, want: "22nd"},
+		{name: "English teens", locale: LocaleEnGB, n: 13, unit: OrdinalDate, want: "13th"},
+		{name: "Portuguese masculine", locale: LocalePtBR, n: 3, unit: OrdinalMonth, want: "3º"},
+		{name: "Portuguese feminine", locale: LocalePtBR, n: 3, unit: OrdinalWeek, want: "3ª"},
+		{name: "French masculine first", locale: LocaleFR, n: 1, unit: OrdinalDate, want: "1er"},
+		{name: "French feminine first", locale: LocaleFR, n: 1, unit: OrdinalWeek, want: "1re"},
+		{name: "French other", locale: LocaleFR, n: 2, unit: OrdinalDate, want: "2e"},
+		{name: "German", locale: LocaleDE, n: 21, unit: OrdinalDate, want: "21."},
+		{name: "Nil rule is English", locale: &Locale{}, n: 1, unit: OrdinalNumber, want: "1st"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.locale.ordinal(tt.n, tt.unit); got != tt.want {
+				t.Errorf("ordinal(%d) = %q, want %q", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPluralRules(t *testing.T) {
+	tests := []struct {
+		name string
+		rule func(int) PluralCategory
+		want map[int]PluralCategory
+	}{
+		{name: "English", rule: pluralOneOther, want: map[int]PluralCategory{
+			0: PluralOther, 1: PluralOne, 2: PluralOther, 21: PluralOther}},
+		{name: "French", rule: pluralFrench, want: map[int]PluralCategory{
+			0: PluralOne, 1: PluralOne, 2: PluralOther}},
+		{name: "Polish", rule: pluralPolish, want: map[int]PluralCategory{
+			1: PluralOne, 2: PluralFew, 4: PluralFew, 5: PluralMany, 12: PluralMany,
+			21: PluralMany, 22: PluralFew, 114: PluralMany, -3: PluralFew}},
+		{name: "Russian", rule: pluralRussian, want: map[int]PluralCategory{
+			1: PluralOne, 11: PluralMany, 21: PluralOne, 3: PluralFew, 13: PluralMany,
+			24: PluralFew, 0: PluralMany, 111: PluralMany}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for n, want := range tt.want {
+				if got := tt.rule(n); got != want {
+					t.Errorf("plural(%d) = %d, want %d", n, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLocaleStandaloneMonths(t *testing.T) {
+	date := time.Date(2024, time.March, 21, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		locale  *Locale
+		pattern string
+		want    string
+	}{
+		{locale: LocalePL, pattern: "d MMMM", want: "21 marca"},
+		{locale: LocalePL, pattern: "LLLL", want: "marzec"},
+		{locale: LocaleRU, pattern: "d MMMM", want: "21 марта"},
+		{locale: LocaleRU, pattern: "LLLL", want: "март"},
+		{locale: LocaleEnUS, pattern: "LLLL", want: "March"},
+	}
+
+	for _, tt := range tests {
+		got, err := FormatLocale(date, tt.pattern, tt.locale)
+		if err != nil || got != tt.want {
+			t.Errorf("FormatLocale(%q, %s) = %q, %v, want %q", tt.pattern, tt.locale.Code, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,1020 @@
+package dateutils
+
+import "time"
+
+var (
+	// LocaleEnUS is American English, the default locale.
+	LocaleEnUS = &Locale{
+		Code: "en-US",
+
+		MonthsWide: [12]string{"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December"},
+		MonthsAbbreviated: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+			"Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		MonthsNarrow: [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+
+		WeekdaysWide:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		WeekdaysAbbreviated: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		WeekdaysShort:       [7]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+		WeekdaysNarrow:      [7]string{"S", "M", "T", "W", "T", "F", "S"},
+
+		MeridiemsWide:        [2]string{"a.m.", "p.m."},
+		MeridiemsAbbreviated: [2]string{"AM", "PM"},
+		MeridiemsNarrow:      [2]string{"a", "p"},
+
+		DayPeriods: DayPeriods{Midnight: "midnight", Noon: "noon", Morning: "in the morning",
+			Afternoon: "in the afternoon", Evening: "in the evening", Night: "at night"},
+		DayPeriodsNarrow: DayPeriods{Midnight: "mi", Noon: "n"},
+
+		ErasWide:        [2]string{"Before Christ", "Anno Domini"},
+		ErasAbbreviated: [2]string{"BC", "AD"},
+		ErasNarrow:      [2]string{"B", "A"},
+
+		QuartersWide:        [4]string{"1st quarter", "2nd quarter", "3rd quarter", "4th quarter"},
+		QuartersAbbreviated: [4]string{"Q1", "Q2", "Q3", "Q4"},
+
+		SpellOut:          spellEnglish,
+		SpelledDateFormat: "'the' dN 'day of' MMMM, yn",
+
+		DateFormats:     [4]string{"MM/dd/yyyy", "MMM d, y", "MMMM do, y", "EEEE, MMMM do, y"},
+		TimeFormats:     [4]string{"h:mm a", "h:mm:ss a", "h:mm:ss a z", "h:mm:ss a zzzz"},
+		DateTimeFormats: [4]string{"{{date}}, {{time}}", "{{date}}, {{time}}", "{{date}} 'at' {{time}}", "{{date}} 'at' {{time}}"},
+
+		WeekStartsOn:          time.Sunday,
+		FirstWeekContainsDate: 1,
+
+		Distance: DistanceTable{
+			LessThanXSeconds: PluralForms{One: "less than a second", Other: "less than {{count}} seconds"},
+			XSeconds:         PluralForms{One: "1 second", Other: "{{count}} seconds"},
+			HalfAMinute:      PluralForms{Other: "half a minute"},
+			LessThanXMinutes: PluralForms{One: "less than a minute", Other: "less than {{count}} minutes"},
+			XMinutes:         PluralForms{One: "1 minute", Other: "{{count}} minutes"},
+			AboutXHours:      PluralForms{One: "about 1 hour", Other: "about {{count}} hours"},
+			XHours:           PluralForms{One: "1 hour", Other: "{{count}} hours"},
+			XDays:            PluralForms{One: "1 day", Other: "{{count}} days"},
+			AboutXWeeks:      PluralForms{One: "about 1 week", Other: "about {{count}} weeks"},
+			XWeeks:           PluralForms{One: "1 week", Other: "{{count}} weeks"},
+			AboutXMonths:     PluralForms{One: "about 1 month", Other: "about {{count}} months"},
+			XMonths:          PluralForms{One: "1 month", Other: "{{count}} months"},
+			AboutXYears:      PluralForms{One: "about 1 year", Other: "about {{count}} years"},
+			XYears:           PluralForms{One: "1 year", Other: "{{count}} years"},
+			OverXYears:       PluralForms{One: "over 1 year", Other: "over {{count}} years"},
+			AlmostXYears:     PluralForms{One: "almost 1 year", Other: "almost {{count}} years"},
+		},
+		DistancePast:   "{{distance}} ago",
+		DistanceFuture: "in {{distance}}",
+
+		Relative: RelativeTable{
+			LastWeek:  "'last' eeee 'at' p",
+			Yesterday: "'yesterday at' p",
+			Today:     "'today at' p",
+			Tomorrow:  "'tomorrow at' p",
+			NextWeek:  "eeee 'at' p",
+			Other:     "P",
+		},
+
+		DurationShort: DurationTable{
+			Years:   PluralForms{One: "{{count}} yr", Other: "{{count}} yrs"},
+			Months:  PluralForms{One: "{{count}} mth", Other: "{{count}} mths"},
+			Weeks:   PluralForms{One: "{{count}} wk", Other: "{{count}} wks"},
+			Days:    PluralForms{One: "{{count}} day", Other: "{{count}} days"},
+			Hours:   PluralForms{Other: "{{count}} hr"},
+			Minutes: PluralForms{Other: "{{count}} min"},
+			Seconds: PluralForms{Other: "{{count}} sec"},
+		},
+		DurationNarrow: DurationTable{
+			Years:   PluralForms{Other: "{{count}}y"},
+			Months:  PluralForms{Other: "{{count}}m"},
+			Weeks:   PluralForms{Other: "{{count}}w"},
+			Days:    PluralForms{Other: "{{count}}d"},
+			Hours:   PluralForms{Other: "{{count}}h"},
+			Minutes: PluralForms{Other: "{{count}}m"},
+			Seconds: PluralForms{Other: "{{count}}s"},
+		},
+		Conjunction: "and",
+
+		IntervalSeparator: " – ",
+		Connectors:        []string{"of", "the", "on"},
+
+		Natural: NaturalGrammar{
+			Now:                []string{"now", "right now"},
+			Today:              []string{"today"},
+			Tomorrow:           []string{"tomorrow"},
+			Yesterday:          []string{"yesterday"},
+			DayAfterTomorrow:   []string{"the day after tomorrow", "day after tomorrow"},
+			DayBeforeYesterday: []string{"the day before yesterday", "day before yesterday"},
+
+			Next: []string{"next", "the next", "the following"},
+			Last: []string{"last", "past", "previous", "the last", "the previous"},
+			This: []string{"this", "this coming", "on"},
+
+			FutureBefore: []string{"in", "within"},
+			FutureAfter:  []string{"from now", "later", "hence"},
+			PastAfter:    []string{"ago", "before now", "earlier"},
+			And:          []string{"and"},
+
+			StartOf: []string{"start of", "start of the", "beginning of", "beginning of the", "the start of", "the beginning of"},
+			EndOf:   []string{"end of", "end of the", "the end of"},
+
+			Years:    []string{"year", "years", "yr", "yrs", "y"},
+			Quarters: []string{"quarter", "quarters", "qtr", "qtrs"},
+			Months:   []string{"month", "months", "mo", "mos"},
+			Weeks:    []string{"week", "weeks", "wk", "wks", "w"},
+			Days:     []string{"day", "days", "d"},
+			Hours:    []string{"hour", "hours", "hr", "hrs", "h"},
+			Minutes:  []string{"minute", "minutes", "min", "mins", "m"},
+			Seconds:  []string{"second", "seconds", "sec", "secs", "s"},
+
+			Numbers: map[string]int{"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4,
+				"five": 5, "six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11,
+				"twelve": 12, "a couple of": 2, "couple of": 2, "a few": 3, "few": 3},
+
+			Weekdays: [7][]string{
+				time.Tuesday:   {"tues"},
+				time.Wednesday: {"weds"},
+				time.Thursday:  {"thur", "thurs"},
+			},
+
+			Noon:     []string{"noon", "midday"},
+			Midnight: []string{"midnight"},
+			AM:       []string{"am", "a.m.", "in the morning"},
+			PM:       []string{"pm", "p.m.", "in the afternoon", "in the evening", "at night"},
+			At:       []string{"at", "@", "by"},
+		},
+	}
+
+	// LocaleEnGB is British English.
+	LocaleEnGB = &Locale{
+		Code: "en-GB",
+
+		MonthsWide:          LocaleEnUS.MonthsWide,
+		MonthsAbbreviated:   LocaleEnUS.MonthsAbbreviated,
+		MonthsNarrow:        LocaleEnUS.MonthsNarrow,
+		WeekdaysWide:        LocaleEnUS.WeekdaysWide,
+		WeekdaysAbbreviated: LocaleEnUS.WeekdaysAbbreviated,
+		WeekdaysShort:       LocaleEnUS.WeekdaysShort,
+		WeekdaysNarrow:      LocaleEnUS.WeekdaysNarrow,
+
+		MeridiemsWide:        LocaleEnUS.MeridiemsWide,
+		MeridiemsAbbreviated: LocaleEnUS.MeridiemsAbbreviated,
+		MeridiemsNarrow:      LocaleEnUS.MeridiemsNarrow,
+		DayPeriods:           LocaleEnUS.DayPeriods,
+		DayPeriodsNarrow:     LocaleEnUS.DayPeriodsNarrow,
+
+		ErasWide:            LocaleEnUS.ErasWide,
+		ErasAbbreviated:     LocaleEnUS.ErasAbbreviated,
+		ErasNarrow:          LocaleEnUS.ErasNarrow,
+		QuartersWide:        LocaleEnUS.QuartersWide,
+		QuartersAbbreviated: LocaleEnUS.QuartersAbbreviated,
+
+		SpellOut:          spellBritish,
+		SpelledDateFormat: "'the' dN 'day of' MMMM yn",
+
+		DateFormats:     [4]string{"dd/MM/yyyy", "d MMM yyyy", "d MMMM yyyy", "EEEE, d MMMM yyyy"},
+		TimeFormats:     [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		DateTimeFormats: [4]string{"{{date}}, {{time}}", "{{date}}, {{time}}", "{{date}} 'at' {{time}}", "{{date}} 'at' {{time}}"},
+
+		WeekStartsOn:          time.Monday,
+		FirstWeekContainsDate: 4,
+
+		Distance:       LocaleEnUS.Distance,
+		DistancePast:   LocaleEnUS.DistancePast,
+		DistanceFuture: LocaleEnUS.DistanceFuture,
+
+		Relative: LocaleEnUS.Relative,
+
+		DurationShort:  LocaleEnUS.DurationShort,
+		DurationNarrow: LocaleEnUS.DurationNarrow,
+		Conjunction:    LocaleEnUS.Conjunction,
+
+		IntervalSeparator: LocaleEnUS.IntervalSeparator,
+		Connectors:        LocaleEnUS.Connectors,
+
+		Natural: LocaleEnUS.Natural,
+	}
+
+	// LocalePtBR is Brazilian Portuguese.
+	LocalePtBR = &Locale{
+		Code: "pt-BR",
+
+		MonthsWide: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+			"julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		MonthsAbbreviated: [12]string{"jan", "fev", "mar", "abr", "mai", "jun",
+			"jul", "ago", "set", "out", "nov", "dez"},
+		MonthsNarrow: [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+
+		WeekdaysWide: [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira",
+			"quinta-feira", "sexta-feira", "sábado"},
+		WeekdaysAbbreviated: [7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+		WeekdaysShort:       [7]string{"do", "se", "te", "qa", "qi", "sx", "sá"},
+		WeekdaysNarrow:      [7]string{"D", "S", "T", "Q", "Q", "S", "S"},
+
+		MeridiemsWide:        [2]string{"a.m.", "p.m."},
+		MeridiemsAbbreviated: [2]string{"AM", "PM"},
+		MeridiemsNarrow:      [2]string{"a", "p"},
+
+		DayPeriods: DayPeriods{Midnight: "meia-noite", Noon: "meio-dia", Morning: "da manhã",
+			Afternoon: "da tarde", Evening: "da noite", Night: "da madrugada"},
+
+		ErasWide:        [2]string{"antes de Cristo", "depois de Cristo"},
+		ErasAbbreviated: [2]string{"a.C.", "d.C."},
+		ErasNarrow:      [2]string{"AC", "DC"},
+
+		QuartersWide:        [4]string{"1º trimestre", "2º trimestre", "3º trimestre", "4º trimestre"},
+		QuartersAbbreviated: [4]string{"T1", "T2", "T3", "T4"},
+
+		Ordinal: portugueseOrdinal,
+
+		SpellOut:          spellPortuguese,
+		SpelledDateFormat: "dn 'de' MMMM 'de' yn",
+
+		DateFormats:     [4]string{"dd/MM/yyyy", "d MMM y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+		TimeFormats:     [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		DateTimeFormats: [4]string{"{{date}}, {{time}}", "{{date}}, {{time}}", "{{date}} 'às' {{time}}", "{{date}} 'às' {{time}}"},
+
+		WeekStartsOn:          time.Sunday,
+		FirstWeekContainsDate: 1,
+
+		Distance: DistanceTable{
+			LessThanXSeconds: PluralForms{One: "menos de um segundo", Other: "menos de {{count}} segundos"},
+			XSeconds:         PluralForms{One: "1 segundo", Other: "{{count}} segundos"},
+			HalfAMinute:      PluralForms{Other: "meio minuto"},
+			LessThanXMinutes: PluralForms{One: "menos de um minuto", Other: "menos de {{count}} minutos"},
+			XMinutes:         PluralForms{One: "1 minuto", Other: "{{count}} minutos"},
+			AboutXHours:      PluralForms{One: "cerca de 1 hora", Other: "cerca de {{count}} horas"},
+			XHours:           PluralForms{One: "1 hora", Other: "{{count}} horas"},
+			XDays:            PluralForms{One: "1 dia", Other: "{{count}} dias"},
+			AboutXWeeks:      PluralForms{One: "cerca de 1 semana", Other: "cerca de {{count}} semanas"},
+			XWeeks:           PluralForms{One: "1 semana", Other: "{{count}} semanas"},
+			AboutXMonths:     PluralForms{One: "cerca de 1 mês", Other: "cerca de {{count}} meses"},
+			XMonths:          PluralForms{One: "1 mês", Other: "{{count}} meses"},
+			AboutXYears:      PluralForms{One: "cerca de 1 ano", Other: "cerca de {{count}} anos"},
+			XYears:           PluralForms{One: "1 ano", Other: "{{count}} anos"},
+			OverXYears:       PluralForms{One: "mais de 1 ano", Other: "mais de {{count}} anos"},
+			AlmostXYears:     PluralForms{One: "quase 1 ano", Other: "quase {{count}} anos"},
+		},
+		DistancePast:   "há {{distance}}",
+		DistanceFuture: "em {{distance}}",
+
+		Relative: RelativeTable{
+			LastWeek:     "'última' eeee 'às' p",
+			Yesterday:    "'ontem às' p",
+			Today:        "'hoje às' p",
+			Tomorrow:     "'amanhã às' p",
+			NextWeek:     "eeee 'às' p",
+			Other:        "P",
+			LastWeekdays: [7]string{time.Sunday: "'último' eeee 'às' p", time.Saturday: "'último' eeee 'às' p"},
+		},
+
+		DurationShort: DurationTable{
+			Years:   PluralForms{One: "{{count}} ano", Other: "{{count}} anos"},
+			Months:  PluralForms{One: "{{count}} mês", Other: "{{count}} meses"},
+			Weeks:   PluralForms{Other: "{{count}} sem."},
+			Days:    PluralForms{One: "{{count}} dia", Other: "{{count}} dias"},
+			Hours:   PluralForms{Other: "{{count}} h"},
+			Minutes: PluralForms{Other: "{{count}} min"},
+			Seconds: PluralForms{Other: "{{count}} s"},
+		},
+		DurationNarrow: DurationTable{
+			Years:   PluralForms{Other: "{{count}}a"},
+			Months:  PluralForms{Other: "{{count}}m"},
+			Weeks:   PluralForms{Other: "{{count}}sem"},
+			Days:    PluralForms{Other: "{{count}}d"},
+			Hours:   PluralForms{Other: "{{count}}h"},
+			Minutes: PluralForms{Other: "{{count}}min"},
+			Seconds: PluralForms{Other: "{{count}}s"},
+		},
+		Conjunction: "e",
+
+		IntervalSeparator: " – ",
+		Connectors:        []string{"de", "em"},
+
+		Natural: NaturalGrammar{
+			Now:                []string{"agora", "agora mesmo"},
+			Today:              []string{"hoje"},
+			Tomorrow:           []string{"amanhã"},
+			Yesterday:          []string{"ontem"},
+			DayAfterTomorrow:   []string{"depois de amanhã"},
+			DayBeforeYesterday: []string{"anteontem", "antes de ontem"},
+
+			Next: []string{"próximo", "próxima", "no próximo", "na próxima", "o próximo", "a próxima",
+				"do próximo", "da próxima", "seguinte"},
+			Last: []string{"último", "última", "no último", "na última", "o último", "a última",
+				"do último", "da última", "passado", "passada"},
+			This: []string{"este", "esta", "neste", "nesta", "esse", "essa", "nesse", "nessa",
+				"deste", "desta", "no", "na"},
+			NextAfter: []string{"que vem", "seguinte"},
+			LastAfter: []string{"passado", "passada", "anterior"},
+
+			FutureBefore: []string{"em", "daqui a", "dentro de", "em até"},
+			FutureAfter:  []string{"depois", "a partir de agora"},
+			PastBefore:   []string{"há", "faz"},
+			PastAfter:    []string{"atrás", "antes"},
+			And:          []string{"e"},
+
+			StartOf: []string{"início de", "início do", "início da", "inicio de", "começo de", "começo do", "começo da",
+				"no início do", "no início da", "no começo do", "no começo da"},
+			EndOf: []string{"fim de", "fim do", "fim da", "final de", "final do", "final da",
+				"no fim do", "no fim da", "no final do", "no final da"},
+
+			Years:    []string{"ano", "anos"},
+			Quarters: []string{"trimestre", "trimestres"},
+			Months:   []string{"mês", "meses"},
+			Weeks:    []string{"semana", "semanas"},
+			Days:     []string{"dia", "dias"},
+			Hours:    []string{"hora", "horas", "h"},
+			Minutes:  []string{"minuto", "minutos", "min"},
+			Seconds:  []string{"segundo", "segundos", "s"},
+
+			Numbers: map[string]int{"um": 1, "uma": 1, "dois": 2, "duas": 2, "três": 3, "quatro": 4,
+				"cinco": 5, "seis": 6, "sete": 7, "oito": 8, "nove": 9, "dez": 10, "onze": 11, "doze": 12},
+
+			Weekdays: [7][]string{
+				time.Monday:    {"segunda"},
+				time.Tuesday:   {"terça"},
+				time.Wednesday: {"quarta"},
+				time.Thursday:  {"quinta"},
+				time.Friday:    {"sexta"},
+			},
+
+			Noon:     []string{"meio-dia", "ao meio-dia"},
+			Midnight: []string{"meia-noite", "à meia-noite"},
+			AM:       []string{"am", "da manhã", "da madrugada"},
+			PM:       []string{"pm", "da tarde", "da noite"},
+			At:       []string{"às", "as", "à", "a", "ao", "por volta das"},
+			HourMark: []string{"h", "hs", "horas"},
+		},
+	}
+
+	// LocalePtPT is European Portuguese.
+	LocalePtPT = &Locale{
+		Code: "pt-PT",
+
+		MonthsWide:          LocalePtBR.MonthsWide,
+		MonthsAbbreviated:   LocalePtBR.MonthsAbbreviated,
+		MonthsNarrow:        LocalePtBR.MonthsNarrow,
+		WeekdaysWide:        LocalePtBR.WeekdaysWide,
+		WeekdaysAbbreviated: LocalePtBR.WeekdaysAbbreviated,
+		WeekdaysShort:       LocalePtBR.WeekdaysShort,
+		WeekdaysNarrow:      LocalePtBR.WeekdaysNarrow,
+
+		MeridiemsWide:        [2]string{"da manhã", "da tarde"},
+		MeridiemsAbbreviated: [2]string{"a.m.", "p.m."},
+		MeridiemsNarrow:      [2]string{"a", "p"},
+
+		DayPeriods: DayPeriods{Midnight: "meia-noite", Noon: "meio-dia", Morning: "da manhã",
+			Afternoon: "da tarde", Evening: "da noite", Night: "da madrugada"},
+
+		ErasWide:            LocalePtBR.ErasWide,
+		ErasAbbreviated:     LocalePtBR.ErasAbbreviated,
+		ErasNarrow:          LocalePtBR.ErasNarrow,
+		QuartersWide:        LocalePtBR.QuartersWide,
+		QuartersAbbreviated: LocalePtBR.QuartersAbbreviated,
+
+		Ordinal: suffixOrdinal("º"),
+
+		SpellOut:          spellEuropeanPortuguese,
+		SpelledDateFormat: "dn 'de' MMMM 'de' yn",
+
+		DateFormats:     [4]string{"dd/MM/y", "d 'de' MMM 'de' y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+		TimeFormats:     [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		DateTimeFormats: [4]string{"{{date}}, {{time}}", "{{date}}, {{time}}", "{{date}} 'às' {{time}}", "{{date}} 'às' {{time}}"},
+
+		WeekStartsOn:          time.Monday,
+		FirstWeekContainsDate: 4,
+
+		Distance:       LocalePtBR.Distance,
+		DistancePast:   "há {{distance}}",
+		DistanceFuture: "daqui a {{distance}}",
+
+		Relative: LocalePtBR.Relative,
+
+		DurationShort:  LocalePtBR.DurationShort,
+		DurationNarrow: LocalePtBR.DurationNarrow,
+		Conjunction:    LocalePtBR.Conjunction,
+
+		IntervalSeparator: LocalePtBR.IntervalSeparator,
+		Connectors:        LocalePtBR.Connectors,
+
+		Natural: LocalePtBR.Natural,
+	}
+
+	// LocaleES is Spanish.
+	LocaleES = &Locale{
+		Code: "es",
+
+		MonthsWide: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		MonthsAbbreviated: [12]string{"ene", "feb", "mar", "abr", "may", "jun",
+			"jul", "ago", "sept", "oct", "nov", "dic"},
+		MonthsNarrow: [12]string{"E", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+
+		WeekdaysWide:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		WeekdaysAbbreviated: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		WeekdaysShort:       [7]string{"do", "lu", "ma", "mi", "ju", "vi", "sá"},
+		WeekdaysNarrow:      [7]string{"D", "L", "M", "M", "J", "V", "S"},
+
+		MeridiemsWide:        [2]string{"a.m.", "p.m."},
+		MeridiemsAbbreviated: [2]string{"AM", "PM"},
+		MeridiemsNarrow:      [2]string{"a", "p"},
+
+		DayPeriods: DayPeriods{Midnight: "medianoche", Noon: "mediodía", Morning: "de la mañana",
+			Afternoon: "de la tarde", Evening: "de la noche", Night: "de la madrugada"},
+
+		ErasWide:        [2]string{"antes de Cristo", "después de Cristo"},
+		ErasAbbreviated: [2]string{"a. C.", "d. C."},
+		ErasNarrow:      [2]string{"AC", "DC"},
+
+		QuartersWide:        [4]string{"1.er trimestre", "2.º trimestre", "3.er trimestre", "4.º trimestre"},
+		QuartersAbbreviated: [4]string{"T1", "T2", "T3", "T4"},
+
+		Ordinal: suffixOrdinal("º"),
+
+		SpellOut:          spellSpanish,
+		SpelledDateFormat: "dn 'de' MMMM 'de' yn",
+
+		DateFormats:     [4]string{"dd/MM/y", "d MMM y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+		TimeFormats:     [4]string{"H:mm", "H:mm:ss", "H:mm:ss z", "H:mm:ss zzzz"},
+		DateTimeFormats: [4]string{"{{date}}, {{time}}", "{{date}}, {{time}}", "{{date}}, {{time}}", "{{date}}, {{time}}"},
+
+		WeekStartsOn:          time.Monday,
+		FirstWeekContainsDate: 4,
+
+		Distance: DistanceTable{
+			LessThanXSeconds: PluralForms{One: "menos de un segundo", Other: "menos de {{count}} segundos"},
+			XSeconds:         PluralForms{One: "1 segundo", Other: "{{count}} segundos"},
+			HalfAMinute:      PluralForms{Other: "medio minuto"},
+			LessThanXMinutes: PluralForms{One: "menos de un minuto", Other: "menos de {{count}} minutos"},
+			XMinutes:         PluralForms{One: "1 minuto", Other: "{{count}} minutos"},
+			AboutXHours:      PluralForms{One: "alrededor de 1 hora", Other: "alrededor de {{count}} horas"},
+			XHours:           PluralForms{One: "1 hora", Other: "{{count}} horas"},
+			XDays:            PluralForms{One: "1 día", Other: "{{count}} días"},
+			AboutXWeeks:      PluralForms{One: "alrededor de 1 semana", Other: "alrededor de {{count}} semanas"},
+			XWeeks:           PluralForms{One: "1 semana", Other: "{{count}} semanas"},
+			AboutXMonths:     PluralForms{One: "alrededor de 1 mes", Other: "alrededor de {{count}} meses"},
+			XMonths:          PluralForms{One: "1 mes", Other: "{{count}} meses"},
+			AboutXYears:      PluralForms{One: "alrededor de 1 año", Other: "alrededor de {{count}} años"},
+			XYears:           PluralForms{One: "1 año", Other: "{{count}} años"},
+			OverXYears:       PluralForms{One: "más de 1 año", Other: "más de {{count}} años"},
+			AlmostXYears:     PluralForms{One: "casi 1 año", Other: "casi {{count}} años"},
+		},
+		DistancePast:   "hace {{distance}}",
+		DistanceFuture: "en {{distance}}",
+
+		Relative: RelativeTable{
+			LastWeek:  "'el' eeee 'pasado a las' p",
+			Yesterday: "'ayer a las' p",
+			Today:     "'hoy a las' p",
+			Tomorrow:  "'mañana a las' p",
+			NextWeek:  "eeee 'a las' p",
+			Other:     "P",
+		},
+
+		DurationShort: DurationTable{
+			Years:   PluralForms{Other: "{{count}} a"},
+			Months:  PluralForms{Other: "{{count}} m"},
+			Weeks:   PluralForms{Other: "{{count}} sem."},
+			Days:    PluralForms{Other: "{{count}} d"},
+			Hours:   PluralForms{Other: "{{count}} h"},
+			Minutes: PluralForms{Other: "{{count}} min"},
+			Seconds: PluralForms{Other: "{{count}} s"},
+		},
+		DurationNarrow: DurationTable{
+			Years:   PluralForms{Other: "{{count}}a"},
+			Months:  PluralForms{Other: "{{count}}m"},
+			Weeks:   PluralForms{Other: "{{count}}sem"},
+			Days:    PluralForms{Other: "{{count}}d"},
+			Hours:   PluralForms{Other: "{{count}}h"},
+			Minutes: PluralForms{Other: "{{count}}min"},
+			Seconds: PluralForms{Other: "{{count}}s"},
+		},
+		Conjunction: "y",
+
+		IntervalSeparator: " – ",
+		Connectors:        []string{"de", "del", "el"},
+	}
+
+	// LocaleFR is French.
+	LocaleFR = &Locale{
+		Code: "fr",
+
+		MonthsWide: [12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		MonthsAbbreviated: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin",
+			"juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		MonthsNarrow: [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+
+		WeekdaysWide:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		WeekdaysAbbreviated: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		WeekdaysShort:       [7]string{"di", "lu", "ma", "me", "je", "ve", "sa"},
+		WeekdaysNarrow:      [7]string{"D", "L", "M", "M", "J", "V", "S"},
+
+		MeridiemsWide:        [2]string{"AM", "PM"},
+		MeridiemsAbbreviated: [2]string{"AM", "PM"},
+		MeridiemsNarrow:      [2]string{"AM", "PM"},
+
+		DayPeriods: DayPeriods{Midnight: "minuit", Noon: "midi", Morning: "du matin",
+			Afternoon: "de l’après-midi", Evening: "du soir", Night: "de la nuit"},
+
+		ErasWide:        [2]string{"avant Jésus-Christ", "après Jésus-Christ"},
+		ErasAbbreviated: [2]string{"av. J.-C.", "ap. J.-C."},
+		ErasNarrow:      [2]string{"av. J.-C.", "ap. J.-C."},
+
+		QuartersWide:        [4]string{"1er trimestre", "2e trimestre", "3e trimestre", "4e trimestre"},
+		QuartersAbbreviated: [4]string{"T1", "T2", "T3", "T4"},
+
+		Ordinal: frenchOrdinal,
+
+		SpellOut:          spellFrench,
+		SpelledDateFormat: "'le' dn MMMM yn",
+
+		DateFormats:     [4]string{"dd/MM/y", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+		TimeFormats:     [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		DateTimeFormats: [4]string{"{{date}} {{time}}", "{{date}}, {{time}}", "{{date}} 'à' {{time}}", "{{date}} 'à' {{time}}"},
+
+		WeekStartsOn:          time.Monday,
+		FirstWeekContainsDate: 4,
+
+		Plural: pluralFrench,
+		Distance: DistanceTable{
+			LessThanXSeconds: PluralForms{One: "moins d’une seconde", Other: "moins de {{count}} secondes"},
+			XSeconds:         PluralForms{One: "{{count}} seconde", Other: "{{count}} secondes"},
+			HalfAMinute:      PluralForms{Other: "30 secondes"},
+			LessThanXMinutes: PluralForms{One: "moins d’une minute", Other: "moins de {{count}} minutes"},
+			XMinutes:         PluralForms{One: "{{count}} minute", Other: "{{count}} minutes"},
+			AboutXHours:      PluralForms{One: "environ {{count}} heure", Other: "environ {{count}} heures"},
+			XHours:           PluralForms{One: "{{count}} heure", Other: "{{count}} heures"},
+			XDays:            PluralForms{One: "{{count}} jour", Other: "{{count}} jours"},
+			AboutXWeeks:      PluralForms{One: "environ {{count}} semaine", Other: "environ {{count}} semaines"},
+			XWeeks:           PluralForms{One: "{{count}} semaine", Other: "{{count}} semaines"},
+			AboutXMonths:     PluralForms{One: "environ {{count}} mois", Other: "environ {{count}} mois"},
+			XMonths:          PluralForms{One: "{{count}} mois", Other: "{{count}} mois"},
+			AboutXYears:      PluralForms{One: "environ {{count}} an", Other: "environ {{count}} ans"},
+			XYears:           PluralForms{One: "{{count}} an", Other: "{{count}} ans"},
+			OverXYears:       PluralForms{One: "plus d’un an", Other: "plus de {{count}} ans"},
+			AlmostXYears:     PluralForms{One: "presqu’un an", Other: "presque {{count}} ans"},
+		},
+		DistancePast:   "il y a {{distance}}",
+		DistanceFuture: "dans {{distance}}",
+
+		Relative: RelativeTable{
+			LastWeek:  "eeee 'dernier à' p",
+			Yesterday: "'hier à' p",
+			Today:     "'aujourd’hui à' p",
+			Tomorrow:  "'demain à' p",
+			NextWeek:  "eeee 'prochain à' p",
+			Other:     "P",
+		},
+
+		DurationShort: DurationTable{
+			Years:   PluralForms{One: "{{count}} an", Other: "{{count}} ans"},
+			Months:  PluralForms{Other: "{{count}} m."},
+			Weeks:   PluralForms{Other: "{{count}} sem."},
+			Days:    PluralForms{Other: "{{count}} j"},
+			Hours:   PluralForms{Other: "{{count}} h"},
+			Minutes: PluralForms{Other: "{{count}} min"},
+			Seconds: PluralForms{Other: "{{count}} s"},
+		},
+		DurationNarrow: DurationTable{
+			Years:   PluralForms{Other: "{{count}}a"},
+			Months:  PluralForms{Other: "{{count}}m"},
+			Weeks:   PluralForms{Other: "{{count}}sem"},
+			Days:    PluralForms{Other: "{{count}}j"},
+			Hours:   PluralForms{Other: "{{count}}h"},
+			Minutes: PluralForms{Other: "{{count}}min"},
+			Seconds: PluralForms{Other: "{{count}}s"},
+		},
+		Conjunction: "et",
+
+		IntervalSeparator: " – ",
+		Connectors:        []string{"le"},
+	}
+
+	// LocaleDE is German.
+	LocaleDE = &Locale{
+		Code: "de",
+
+		MonthsWide: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember"},
+		MonthsAbbreviated: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni",
+			"Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		MonthsNarrow: [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+
+		WeekdaysWide:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		WeekdaysAbbreviated: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		WeekdaysShort:       [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		WeekdaysNarrow:      [7]string{"S", "M", "D", "M", "D", "F", "S"},
+
+		MeridiemsWide:        [2]string{"vormittags", "nachmittags"},
+		MeridiemsAbbreviated: [2]string{"vorm.", "nachm."},
+		MeridiemsNarrow:      [2]string{"vm.", "nm."},
+
+		DayPeriods: DayPeriods{Midnight: "Mitternacht", Noon: "Mittag", Morning: "morgens",
+			Afternoon: "nachmittags", Evening: "abends", Night: "nachts"},
+
+		ErasWide:        [2]string{"vor Christus", "nach Christus"},
+		ErasAbbreviated: [2]string{"v. Chr.", "n. Chr."},
+		ErasNarrow:      [2]string{"v. Chr.", "n. Chr."},
+
+		QuartersWide:        [4]string{"1. Quartal", "2. Quartal", "3. Quartal", "4. Quartal"},
+		QuartersAbbreviated: [4]string{"Q1", "Q2", "Q3", "Q4"},
+
+		Ordinal: suffixOrdinal("."),
+
+		SpellOut:          spellGerman,
+		SpelledDateFormat: "'der' dN MMMM yn",
+
+		DateFormats:     [4]string{"dd.MM.y", "do MMM y", "do MMMM y", "EEEE, do MMMM y"},
+		TimeFormats:     [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		DateTimeFormats: [4]string{"{{date}} {{time}}", "{{date}} {{time}}", "{{date}} 'um' {{time}}", "{{date}} 'um' {{time}}"},
+
+		WeekStartsOn:          time.Monday,
+		FirstWeekContainsDate: 4,
+
+		Distance: DistanceTable{
+			LessThanXSeconds: PluralForms{One: "weniger als 1 Sekunde", Other: "weniger als {{count}} Sekunden"},
+			XSeconds:         PluralForms{One: "1 Sekunde", Other: "{{count}} Sekunden"},
+			HalfAMinute:      PluralForms{Other: "eine halbe Minute"},
+			LessThanXMinutes: PluralForms{One: "weniger als 1 Minute", Other: "weniger als {{count}} Minuten"},
+			XMinutes:         PluralForms{One: "1 Minute", Other: "{{count}} Minuten"},
+			AboutXHours:      PluralForms{One: "etwa 1 Stunde", Other: "etwa {{count}} Stunden"},
+			XHours:           PluralForms{One: "1 Stunde", Other: "{{count}} Stunden"},
+			XDays:            PluralForms{One: "1 Tag", Other: "{{count}} Tage"},
+			AboutXWeeks:      PluralForms{One: "etwa 1 Woche", Other: "etwa {{count}} Wochen"},
+			XWeeks:           PluralForms{One: "1 Woche", Other: "{{count}} Wochen"},
+			AboutXMonths:     PluralForms{One: "etwa 1 Monat", Other: "etwa {{count}} Monate"},
+			XMonths:          PluralForms{One: "1 Monat", Other: "{{count}} Monate"},
+			AboutXYears:      PluralForms{One: "etwa 1 Jahr", Other: "etwa {{count}} Jahre"},
+			XYears:           PluralForms{One: "1 Jahr", Other: "{{count}} Jahre"},
+			OverXYears:       PluralForms{One: "mehr als 1 Jahr", Other: "mehr als {{count}} Jahre"},
+			AlmostXYears:     PluralForms{One: "fast 1 Jahr", Other: "fast {{count}} Jahre"},
+		},
+		// Dative forms after "vor" and "in"
+		DistanceWithSuffix: DistanceTable{
+			LessThanXSeconds: PluralForms{One: "weniger als einer Sekunde"},
+			HalfAMinute:      PluralForms{Other: "einer halben Minute"},
+			LessThanXMinutes: PluralForms{One: "weniger als einer Minute"},
+			XDays:            PluralForms{Other: "{{count}} Tagen"},
+			AboutXMonths:     PluralForms{Other: "etwa {{count}} Monaten"},
+			XMonths:          PluralForms{Other: "{{count}} Monaten"},
+			AboutXYears:      PluralForms{Other: "etwa {{count}} Jahren"},
+			XYears:           PluralForms{Other: "{{count}} Jahren"},
+			OverXYears:       PluralForms{Other: "mehr als {{count}} Jahren"},
+			AlmostXYears:     PluralForms{Other: "fast {{count}} Jahren"},
+		},
+		DistancePast:   "vor {{distance}}",
+		DistanceFuture: "in {{distance}}",
+
+		Relative: RelativeTable{
+			LastWeek:  "'letzten' eeee 'um' p",
+			Yesterday: "'gestern um' p",
+			Today:     "'heute um' p",
+			Tomorrow:  "'morgen um' p",
+			NextWeek:  "eeee 'um' p",
+			Other:     "P",
+		},
+
+		DurationShort: DurationTable{
+			Years:   PluralForms{Other: "{{count}} J."},
+			Months:  PluralForms{Other: "{{count}} Mon."},
+			Weeks:   PluralForms{Other: "{{count}} Wo."},
+			Days:    PluralForms{Other: "{{count}} Tg."},
+			Hours:   PluralForms{Other: "{{count}} Std."},
+			Minutes: PluralForms{Other: "{{count}} Min."},
+			Seconds: PluralForms{Other: "{{count}} Sek."},
+		},
+		DurationNarrow: DurationTable{
+			Years:   PluralForms{Other: "{{count}}J"},
+			Months:  PluralForms{Other: "{{count}}M"},
+			Weeks:   PluralForms{Other: "{{count}}W"},
+			Days:    PluralForms{Other: "{{count}}T"},
+			Hours:   PluralForms{Other: "{{count}}h"},
+			Minutes: PluralForms{Other: "{{count}}m"},
+			Seconds: PluralForms{Other: "{{count}}s"},
+		},
+		Conjunction: "und",
+
+		IntervalSeparator: "–",
+		Connectors:        []string{"den", "am"},
+	}
+
+	// LocaleIT is Italian.
+	LocaleIT = &Locale{
+		Code: "it",
+
+		MonthsWide: [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno",
+			"luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		MonthsAbbreviated: [12]string{"gen", "feb", "mar", "apr", "mag", "giu",
+			"lug", "ago", "set", "ott", "nov", "dic"},
+		MonthsNarrow: [12]string{"G", "F", "M", "A", "M", "G", "L", "A", "S", "O", "N", "D"},
+
+		WeekdaysWide:        [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		WeekdaysAbbreviated: [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		WeekdaysShort:       [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		WeekdaysNarrow:      [7]string{"D", "L", "M", "M", "G", "V", "S"},
+
+		MeridiemsWide:        [2]string{"AM", "PM"},
+		MeridiemsAbbreviated: [2]string{"AM", "PM"},
+		MeridiemsNarrow:      [2]string{"m.", "p."},
+
+		DayPeriods: DayPeriods{Midnight: "mezzanotte", Noon: "mezzogiorno", Morning: "di mattina",
+			Afternoon: "del pomeriggio", Evening: "di sera", Night: "di notte"},
+
+		ErasWide:        [2]string{"avanti Cristo", "dopo Cristo"},
+		ErasAbbreviated: [2]string{"a.C.", "d.C."},
+		ErasNarrow:      [2]string{"aC", "dC"},
+
+		QuartersWide:        [4]string{"1º trimestre", "2º trimestre", "3º trimestre", "4º trimestre"},
+		QuartersAbbreviated: [4]string{"T1", "T2", "T3", "T4"},
+
+		Ordinal: suffixOrdinal("º"),
+
+		SpellOut:          spellItalian,
+		SpelledDateFormat: "'il' dn MMMM yn",
+
+		DateFormats:     [4]string{"dd/MM/y", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+		TimeFormats:     [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		DateTimeFormats: [4]string{"{{date}}, {{time}}", "{{date}}, {{time}}", "{{date}} {{time}}", "{{date}} {{time}}"},
+
+		WeekStartsOn:          time.Monday,
+		FirstWeekContainsDate: 4,
+
+		Distance: DistanceTable{
+			LessThanXSeconds: PluralForms{One: "meno di un secondo", Other: "meno di {{count}} secondi"},
+			XSeconds:         PluralForms{One: "un secondo", Other: "{{count}} secondi"},
+			HalfAMinute:      PluralForms{Other: "alcuni secondi"},
+			LessThanXMinutes: PluralForms{One: "meno di un minuto", Other: "meno di {{count}} minuti"},
+			XMinutes:         PluralForms{One: "un minuto", Other: "{{count}} minuti"},
+			AboutXHours:      PluralForms{One: "circa un’ora", Other: "circa {{count}} ore"},
+			XHours:           PluralForms{One: "un’ora", Other: "{{count}} ore"},
+			XDays:            PluralForms{One: "un giorno", Other: "{{count}} giorni"},
+			AboutXWeeks:      PluralForms{One: "circa una settimana", Other: "circa {{count}} settimane"},
+			XWeeks:           PluralForms{One: "una settimana", Other: "{{count}} settimane"},
+			AboutXMonths:     PluralForms{One: "circa un mese", Other: "circa {{count}} mesi"},
+			XMonths:          PluralForms{One: "un mese", Other: "{{count}} mesi"},
+			AboutXYears:      PluralForms{One: "circa un anno", Other: "circa {{count}} anni"},
+			XYears:           PluralForms{One: "un anno", Other: "{{count}} anni"},
+			OverXYears:       PluralForms{One: "più di un anno", Other: "più di {{count}} anni"},
+			AlmostXYears:     PluralForms{One: "quasi un anno", Other: "quasi {{count}} anni"},
+		},
+		DistancePast:   "{{distance}} fa",
+		DistanceFuture: "tra {{distance}}",
+
+		Relative: RelativeTable{
+			LastWeek:     "eeee 'scorso alle' p",
+			Yesterday:    "'ieri alle' p",
+			Today:        "'oggi alle' p",
+			Tomorrow:     "'domani alle' p",
+			NextWeek:     "eeee 'alle' p",
+			Other:        "P",
+			LastWeekdays: [7]string{time.Sunday: "eeee 'scorsa alle' p"},
+		},
+
+		DurationShort: DurationTable{
+			Years:   PluralForms{One: "{{count}} anno", Other: "{{count}} anni"},
+			Months:  PluralForms{One: "{{count}} mese", Other: "{{count}} mesi"},
+			Weeks:   PluralForms{Other: "{{count}} sett."},
+			Days:    PluralForms{Other: "{{count}} gg"},
+			Hours:   PluralForms{Other: "{{count}} h"},
+			Minutes: PluralForms{Other: "{{count}} min"},
+			Seconds: PluralForms{Other: "{{count}} s"},
+		},
+		DurationNarrow: DurationTable{
+			Years:   PluralForms{Other: "{{count}}a"},
+			Months:  PluralForms{Other: "{{count}}m"},
+			Weeks:   PluralForms{Other: "{{count}}sett."},
+			Days:    PluralForms{Other: "{{count}}g"},
+			Hours:   PluralForms{Other: "{{count}}h"},
+			Minutes: PluralForms{Other: "{{count}}min"},
+			Seconds: PluralForms{Other: "{{count}}s"},
+		},
+		Conjunction: "e",
+
+		IntervalSeparator: " – ",
+		Connectors:        []string{"di", "del", "il"},
+	}
+
+	// LocalePL is Polish.
+	LocalePL = &Locale{
+		Code: "pl",
+
+		MonthsWide: [12]string{"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca",
+			"lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+		MonthsAbbreviated: [12]string{"sty", "lut", "mar", "kwi", "maj", "cze",
+			"lip", "sie", "wrz", "paź", "lis", "gru"},
+		MonthsNarrow: [12]string{"S", "L", "M", "K", "M", "C", "L", "S", "W", "P", "L", "G"},
+		MonthsWideStandalone: [12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec",
+			"lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+
+		WeekdaysWide:        [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+		WeekdaysAbbreviated: [7]string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."},
+		WeekdaysShort:       [7]string{"nie", "pon", "wto", "śro", "czw", "pią", "sob"},
+		WeekdaysNarrow:      [7]string{"N", "P", "W", "Ś", "C", "P", "S"},
+
+		MeridiemsWide:        [2]string{"AM", "PM"},
+		MeridiemsAbbreviated: [2]string{"AM", "PM"},
+		MeridiemsNarrow:      [2]string{"a", "p"},
+
+		DayPeriods: DayPeriods{Midnight: "o północy", Noon: "w południe", Morning: "rano",
+			Afternoon: "po południu", Evening: "wieczorem", Night: "w nocy"},
+
+		ErasWide:        [2]string{"przed naszą erą", "naszej ery"},
+		ErasAbbreviated: [2]string{"p.n.e.", "n.e."},
+		ErasNarrow:      [2]string{"p.n.e.", "n.e."},
+
+		QuartersWide:        [4]string{"I kwartał", "II kwartał", "III kwartał", "IV kwartał"},
+		QuartersAbbreviated: [4]string{"I kw.", "II kw.", "III kw.", "IV kw."},
+
+		Ordinal: suffixOrdinal("."),
+
+		DateFormats:     [4]string{"dd.MM.y", "d MMM y", "d MMMM y", "EEEE, d MMMM y"},
+		TimeFormats:     [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		DateTimeFormats: [4]string{"{{date}}, {{time}}", "{{date}}, {{time}}", "{{date}} {{time}}", "{{date}} {{time}}"},
+
+		WeekStartsOn:          time.Monday,
+		FirstWeekContainsDate: 4,
+
+		Plural: pluralPolish,
+		Distance: DistanceTable{
+			LessThanXSeconds: PluralForms{One: "mniej niż sekunda", Few: "mniej niż {{count}} sekundy", Many: "mniej niż {{count}} sekund"},
+			XSeconds:         PluralForms{One: "1 sekunda", Few: "{{count}} sekundy", Many: "{{count}} sekund"},
+			HalfAMinute:      PluralForms{Other: "pół minuty"},
+			LessThanXMinutes: PluralForms{One: "mniej niż minuta", Few: "mniej niż {{count}} minuty", Many: "mniej niż {{count}} minut"},
+			XMinutes:         PluralForms{One: "1 minuta", Few: "{{count}} minuty", Many: "{{count}} minut"},
+			AboutXHours:      PluralForms{One: "około godziny", Few: "około {{count}} godzin", Many: "około {{count}} godzin"},
+			XHours:           PluralForms{One: "1 godzina", Few: "{{count}} godziny", Many: "{{count}} godzin"},
+			XDays:            PluralForms{One: "1 dzień", Few: "{{count}} dni", Many: "{{count}} dni"},
+			AboutXWeeks:      PluralForms{One: "około tygodnia", Few: "około {{count}} tygodni", Many: "około {{count}} tygodni"},
+			XWeeks:           PluralForms{One: "1 tydzień", Few: "{{count}} tygodnie", Many: "{{count}} tygodni"},
+			AboutXMonths:     PluralForms{One: "około miesiąca", Few: "około {{count}} miesięcy", Many: "około {{count}} miesięcy"},
+			XMonths:          PluralForms{One: "1 miesiąc", Few: "{{count}} miesiące", Many: "{{count}} miesięcy"},
+			AboutXYears:      PluralForms{One: "około roku", Few: "około {{count}} lat", Many: "około {{count}} lat"},
+			XYears:           PluralForms{One: "1 rok", Few: "{{count}} lata", Many: "{{count}} lat"},
+			OverXYears:       PluralForms{One: "ponad rok", Few: "ponad {{count}} lata", Many: "ponad {{count}} lat"},
+			AlmostXYears:     PluralForms{One: "prawie rok", Few: "prawie {{count}} lata", Many: "prawie {{count}} lat"},
+		},
+		// Accusative forms after "za" and before "temu"
+		DistanceWithSuffix: DistanceTable{
+			LessThanXSeconds: PluralForms{One: "mniej niż sekundę"},
+			XSeconds:         PluralForms{One: "1 sekundę"},
+			LessThanXMinutes: PluralForms{One: "mniej niż minutę"},
+			XMinutes:         PluralForms{One: "1 minutę"},
+			XHours:           PluralForms{One: "1 godzinę"},
+		},
+		DistancePast:   "{{distance}} temu",
+		DistanceFuture: "za {{distance}}",
+
+		Relative: RelativeTable{
+			Yesterday: "'wczoraj o' p",
+			Today:     "'dzisiaj o' p",
+			Tomorrow:  "'jutro o' p",
+			Other:     "P",
+			LastWeekdays: [7]string{
+				"'w zeszłą niedzielę o' p", "'w zeszły poniedziałek o' p", "'w zeszły wtorek o' p",
+				"'w zeszłą środę o' p", "'w zeszły czwartek o' p", "'w zeszły piątek o' p", "'w zeszłą sobotę o' p",
+			},
+			NextWeekdays: [7]string{
+				"'w następną niedzielę o' p", "'w następny poniedziałek o' p", "'w następny wtorek o' p",
+				"'w następną środę o' p", "'w następny czwartek o' p", "'w następny piątek o' p", "'w następną sobotę o' p",
+			},
+			ThisWeekdays: [7]string{
+				"'w niedzielę o' p", "'w poniedziałek o' p", "'we wtorek o' p",
+				"'w środę o' p", "'w czwartek o' p", "'w piątek o' p", "'w sobotę o' p",
+			},
+		},
+
+		DurationShort: DurationTable{
+			Years:   PluralForms{Other: "{{count}} r."},
+			Months:  PluralForms{Other: "{{count}} mies."},
+			Weeks:   PluralForms{Other: "{{count}} tydz."},
+			Days:    PluralForms{One: "{{count}} dzień", Other: "{{count}} dni"},
+			Hours:   PluralForms{Other: "{{count}} godz."},
+			Minutes: PluralForms{Other: "{{count}} min"},
+			Seconds: PluralForms{Other: "{{count}} sek."},
+		},
+		DurationNarrow: DurationTable{
+			Years:   PluralForms{Other: "{{count}}r"},
+			Months:  PluralForms{Other: "{{count}}m"},
+			Weeks:   PluralForms{Other: "{{count}}t"},
+			Days:    PluralForms{Other: "{{count}}d"},
+			Hours:   PluralForms{Other: "{{count}}g"},
+			Minutes: PluralForms{Other: "{{count}}min"},
+			Seconds: PluralForms{Other: "{{count}}s"},
+		},
+		Conjunction: "i",
+
+		IntervalSeparator: "–",
+		Connectors:        []string{"dnia", "r.", "roku"},
+	}
+
+	// LocaleRU is Russian.
+	LocaleRU = &Locale{
+		Code: "ru",
+
+		MonthsWide: [12]string{"января", "февраля", "марта", "апреля", "мая", "июня",
+			"июля", "августа", "сентября", "октября", "ноября", "декабря"},
+		MonthsAbbreviated: [12]string{"янв.", "фев.", "мар.", "апр.", "мая", "июн.",
+			"июл.", "авг.", "сент.", "окт.", "нояб.", "дек."},
+		MonthsNarrow: [12]string{"Я", "Ф", "М", "А", "М", "И", "И", "А", "С", "О", "Н", "Д"},
+		MonthsWideStandalone: [12]string{"январь", "февраль", "март", "апрель", "май", "июнь",
+			"июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+
+		WeekdaysWide:        [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+		WeekdaysAbbreviated: [7]string{"вск", "пнд", "втр", "срд", "чтв", "птн", "суб"},
+		WeekdaysShort:       [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+		WeekdaysNarrow:      [7]string{"В", "П", "В", "С", "Ч", "П", "С"},
+
+		MeridiemsWide:        [2]string{"ДП", "ПП"},
+		MeridiemsAbbreviated: [2]string{"ДП", "ПП"},
+		MeridiemsNarrow:      [2]string{"ДП", "ПП"},
+
+		DayPeriods: DayPeriods{Midnight: "полночь", Noon: "полдень", Morning: "утра",
+			Afternoon: "дня", Evening: "вечера", Night: "ночи"},
+
+		ErasWide:        [2]string{"до нашей эры", "нашей эры"},
+		ErasAbbreviated: [2]string{"до н. э.", "н. э."},
+		ErasNarrow:      [2]string{"до н.э.", "н.э."},
+
+		QuartersWide:        [4]string{"1-й квартал", "2-й квартал", "3-й квартал", "4-й квартал"},
+		QuartersAbbreviated: [4]string{"1-й кв.", "2-й кв.", "3-й кв.", "4-й кв."},
+
+		Ordinal: russianOrdinal,
+
+		DateFormats:     [4]string{"dd.MM.y", "d MMM y 'г.'", "d MMMM y 'г.'", "EEEE, d MMMM y 'г.'"},
+		TimeFormats:     [4]string{"H:mm", "H:mm:ss", "H:mm:ss z", "H:mm:ss zzzz"},
+		DateTimeFormats: [4]string{"{{date}}, {{time}}", "{{date}}, {{time}}", "{{date}}, {{time}}", "{{date}}, {{time}}"},
+
+		WeekStartsOn:          time.Monday,
+		FirstWeekContainsDate: 1,
+
+		Plural: pluralRussian,
+		Distance: DistanceTable{
+			LessThanXSeconds: PluralForms{One: "меньше {{count}} секунды", Few: "меньше {{count}} секунд", Many: "меньше {{count}} секунд"},
+			XSeconds:         PluralForms{One: "{{count}} секунда", Few: "{{count}} секунды", Many: "{{count}} секунд"},
+			HalfAMinute:      PluralForms{Other: "полминуты"},
+			LessThanXMinutes: PluralForms{One: "меньше {{count}} минуты", Few: "меньше {{count}} минут", Many: "меньше {{count}} минут"},
+			XMinutes:         PluralForms{One: "{{count}} минута", Few: "{{count}} минуты", Many: "{{count}} минут"},
+			AboutXHours:      PluralForms{One: "около {{count}} часа", Few: "около {{count}} часов", Many: "около {{count}} часов"},
+			XHours:           PluralForms{One: "{{count}} час", Few: "{{count}} часа", Many: "{{count}} часов"},
+			XDays:            PluralForms{One: "{{count}} день", Few: "{{count}} дня", Many: "{{count}} дней"},
+			AboutXWeeks:      PluralForms{One: "около {{count}} недели", Few: "около {{count}} недель", Many: "около {{count}} недель"},
+			XWeeks:           PluralForms{One: "{{count}} неделя", Few: "{{count}} недели", Many: "{{count}} недель"},
+			AboutXMonths:     PluralForms{One: "около {{count}} месяца", Few: "около {{count}} месяцев", Many: "около {{count}} месяцев"},
+			XMonths:          PluralForms{One: "{{count}} месяц", Few: "{{count}} месяца", Many: "{{count}} месяцев"},
+			AboutXYears:      PluralForms{One: "около {{count}} года", Few: "около {{count}} лет", Many: "около {{count}} лет"},
+			XYears:           PluralForms{One: "{{count}} год", Few: "{{count}} года", Many: "{{count}} лет"},
+			OverXYears:       PluralForms{One: "больше {{count}} года", Few: "больше {{count}} лет", Many: "больше {{count}} лет"},
+			AlmostXYears:     PluralForms{One: "почти {{count}} год", Few: "почти {{count}} года", Many: "почти {{count}} лет"},
+		},
+		// Accusative forms after "через" and before "назад"
+		DistanceWithSuffix: DistanceTable{
+			XSeconds: PluralForms{One: "{{count}} секунду"},
+			XMinutes: PluralForms{One: "{{count}} минуту"},
+			XWeeks:   PluralForms{One: "{{count}} неделю"},
+		},
+		DistancePast:   "{{distance}} назад",
+		DistanceFuture: "через {{distance}}",
+
+		Relative: RelativeTable{
+			Yesterday: "'вчера в' p",
+			Today:     "'сегодня в' p",
+			Tomorrow:  "'завтра в' p",
+			Other:     "P",
+			LastWeekdays: [7]string{
+				"'в прошлое воскресенье в' p", "'в прошлый понедельник в' p", "'в прошлый вторник в' p",
+				"'в прошлую среду в' p", "'в прошлый четверг в' p", "'в прошлую пятницу в' p", "'в прошлую субботу в' p",
+			},
+			NextWeekdays: [7]string{
+				"'в следующее воскресенье в' p", "'в следующий понедельник в' p", "'в следующий вторник в' p",
+				"'в следующую среду в' p", "'в следующий четверг в' p", "'в следующую пятницу в' p", "'в следующую субботу в' p",
+			},
+			ThisWeekdays: [7]string{
+				"'в воскресенье в' p", "'в понедельник в' p", "'во вторник в' p",
+				"'в среду в' p", "'в четверг в' p", "'в пятницу в' p", "'в субботу в' p",
+			},
+		},
+
+		DurationShort: DurationTable{
+			Years:   PluralForms{One: "{{count}} г.", Few: "{{count}} г.", Many: "{{count}} л.", Other: "{{count}} г."},
+			Months:  PluralForms{Other: "{{count}} мес."},
+			Weeks:   PluralForms{Other: "{{count}} нед."},
+			Days:    PluralForms{Other: "{{count}} дн."},
+			Hours:   PluralForms{Other: "{{count}} ч"},
+			Minutes: PluralForms{Other: "{{count}} мин"},
+			Seconds: PluralForms{Other: "{{count}} с"},
+		},
+		DurationNarrow: DurationTable{
+			Years:   PluralForms{Other: "{{count}} г."},
+			Months:  PluralForms{Other: "{{count}} м."},
+			Weeks:   PluralForms{Other: "{{count}} н."},
+			Days:    PluralForms{Other: "{{count}} д"},
+			Hours:   PluralForms{Other: "{{count}} ч"},
+			Minutes: PluralForms{Other: "{{count}} мин"},
+			Seconds: PluralForms{Other: "{{count}} с"},
+		},
+		Conjunction: "и",
+
+		IntervalSeparator: "–",
+		Connectors:        []string{"г.", "года"},
+	}
+)
@@ -0,0 +1,479 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LogFormat identifies a log timestamp format recognized by ParseLogTimestamp.
+type LogFormat int
+
+const (
+	// LogFormatRFC5424 is the syslog protocol timestamp, an RFC 3339 time
+	// with at most six fraction digits: "<34>1 2003-10-11T22:14:15.003Z".
+	LogFormatRFC5424 LogFormat = iota + 1
+	// LogFormatRFC3164 is the BSD syslog timestamp, which has no year:
+	// "<34>Oct 11 22:14:15".
+	LogFormatRFC3164
+	// LogFormatCommonLog is the Apache and nginx Common Log Format time,
+	// found between brackets: "[10/Oct/2000:13:55:36 -0700]".
+	LogFormatCommonLog
+	// LogFormatLog4j is the log4j ISO 8601 date: "2000-10-10 13:55:36,123".
+	LogFormatLog4j
+	// LogFormatGoLog is the Go log package date with the standard flags,
+	// optionally with microseconds: "2009/01/23 01:23:23.123123".
+	LogFormatGoLog
+	// LogFormatJavaLogging is the java.util.logging SimpleFormatter date:
+	// "Oct 10, 2000 1:55:36 PM".
+	LogFormatJavaLogging
+)
+
+// String returns the name of the log format.
+func (f LogFormat) String() string {
+	switch f {
+	case LogFormatRFC5424:
+		return "RFC 5424"
+	case LogFormatRFC3164:
+		return "RFC 3164"
+	case LogFormatCommonLog:
+		return "Common Log Format"
+	case LogFormatLog4j:
+		return "log4j"
+	case LogFormatGoLog:
+		return "Go log"
+	case LogFormatJavaLogging:
+		return "java.util.logging"
+	default:
+		return fmt.Sprintf("LogFormat(%d)", int(f))
+	}
+}
+
+// logFormats lists every log format in the order ParseLogTimestamp tries them.
+var logFormats = []LogFormat{
+	LogFormatRFC5424,
+	LogFormatRFC3164,
+	LogFormatCommonLog,
+	LogFormatLog4j,
+	LogFormatGoLog,
+	LogFormatJavaLogging,
+}
+
+// logLayouts are Go layouts equivalent to each log format, reported in errors.
+var logLayouts = map[LogFormat]string{
+	LogFormatRFC5424:     time.RFC3339Nano,
+	LogFormatRFC3164:     time.Stamp,
+	LogFormatCommonLog:   "02/Jan/2006:15:04:05 -0700",
+	LogFormatLog4j:       "2006-01-02 15:04:05,000",
+	LogFormatGoLog:       "2006/01/02 15:04:05.000000",
+	LogFormatJavaLogging: "Jan 02, 2006 3:04:05 PM",
+}
+
+// LogTimestampOptions configures ParseLogTimestamp.
+type LogTimestampOptions struct {
+	// Formats lists the formats to try, in order. Nil tries all of them.
+	Formats []LogFormat
+	// Reference is the time the line is read at, used to infer the year of
+	// RFC 3164 timestamps. The zero value means time.Now().
+	Reference time.Time
+	// Location is used for timestamps without an offset. Nil means UTC.
+	Location *time.Location
+	// FutureTolerance is how far after Reference an inferred date may fall,
+	// to allow for clock skew between hosts. Zero means one day.
+	FutureTolerance time.Duration
+}
+
+// LogTimestamp is a timestamp found in a log line.
+type LogTimestamp struct {
+	Time         time.Time
+	Format       LogFormat // The format that matched
+	Start, End   int       // Byte offsets of the timestamp in the line
+	YearInferred bool      // Whether the year was inferred from the reference time
+}
+
+// ParseLogTimestamp parses the timestamp of a log line, trying the syslog
+// (RFC 5424 and RFC 3164), Common Log Format, log4j, Go log and
+// java.util.logging formats in that order, or only opts.Formats.
+//
+// Timestamps are read at the start of the line, after the "<PRI>" and
+// version of a syslog header, except Common Log Format times, which are read
+// from the first bracketed field so a whole access log line can be passed.
+// Text after the timestamp is ignored; its end offset is returned.
+//
+// RFC 3164 timestamps have no year. It is inferred as the latest year that
+// does not put the date more than opts.FutureTolerance after opts.Reference,
+// so a December line read in January belongs to the previous year and a
+// January line read late on December 31 to the next one. Timestamps with an
+// offset keep it; the others are in opts.Location.
+// Returns a *ParseError listing the attempted formats if none matches, or an
+// error wrapping ErrEmptyInput for an empty line.
+//
+// Example:
+//
+//	opts := &LogTimestampOptions{Reference: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)}
+//	ts, _ := ParseLogTimestamp("<34>Dec 31 23:59:58 host su: denied", opts)
+//	// ts.Time is 2024-12-31 23:59:58 UTC, ts.Format is LogFormatRFC3164
+//	ts, _ = ParseLogTimestamp(`127.0.0.1 - - [10/Oct/2000:13:55:36 -0700] "GET / HTTP/1.0" 200`, nil)
+//	// ts.Time is 2000-10-10 13:55:36 -0700, ts.Format is LogFormatCommonLog
+func ParseLogTimestamp(line string, opts *LogTimestampOptions) (LogTimestamp, error) {
+	if strings.TrimSpace(line) == "" {
+		return LogTimestamp{}, fmt.Errorf("cannot parse log timestamp: %w", ErrEmptyInput)
+	}
+	var o LogTimestampOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Formats == nil {
+		o.Formats = logFormats
+	}
+	if o.Reference.IsZero() {
+		o.Reference = time.Now()
+	}
+	if o.Location == nil {
+		o.Location = time.UTC
+	}
+	if o.FutureTolerance == 0 {
+		o.FutureTolerance = 24 * time.Hour
+	}
+
+	attempts := make([]LayoutAttempt, 0, len(o.Formats))
+	for _, format := range o.Formats {
+		layout, ok := logLayouts[format]
+		if !ok {
+			return LogTimestamp{}, fmt.Errorf("cannot parse log timestamp: unknown format %s", format)
+		}
+		in := &tokenInput{s: line}
+		ts, reason := readLogTimestamp(in, format, &o)
+		if reason == "" {
+			ts.Format = format
+			return ts, nil
+		}
+		attempts = append(attempts, LayoutAttempt{Layout: layout, Offset: in.pos, Err: errors.New(reason)})
+	}
+	return LogTimestamp{}, newParseError(line, attempts)
+}
+
+// readLogTimestamp reads the timestamp of one format, returning a reason on
+// failure with in.pos at the offending byte.
+func readLogTimestamp(in *tokenInput, format LogFormat, o *LogTimestampOptions) (LogTimestamp, string) {
+	switch format {
+	case LogFormatRFC5424:
+		return readRFC5424Timestamp(in)
+	case LogFormatRFC3164:
+		return readRFC3164Timestamp(in, o)
+	case LogFormatCommonLog:
+		return readCommonLogTimestamp(in)
+	case LogFormatLog4j:
+		return readLog4jTimestamp(in, o.Location)
+	case LogFormatGoLog:
+		return readGoLogTimestamp(in, o.Location)
+	default:
+		return readJavaLoggingTimestamp(in, o.Location)
+	}
+}
+
+// readRFC5424Timestamp reads "<34>1 2003-10-11T22:14:15.003Z", where the
+// header is optional.
+func readRFC5424Timestamp(in *tokenInput) (LogTimestamp, string) {
+	if in.pos < len(in.s) && in.s[in.pos] == '<' {
+		if reason := skipSyslogPriority(in); reason != "" {
+			return LogTimestamp{}, reason
+		}
+		if version, ok := in.digits(1, 2); !ok || version == 0 {
+			return LogTimestamp{}, "expected the syslog version after the priority"
+		}
+		if !in.literal(" ") {
+			return LogTimestamp{}, "expected a space after the syslog version"
+		}
+	}
+	start := in.pos
+	if rest := in.rest(); rest == "-" || strings.HasPrefix(rest, "- ") {
+		return LogTimestamp{}, `the timestamp is the nil value "-"`
+	}
+	end := strings.IndexByte(in.rest(), ' ')
+	if end < 0 {
+		end = len(in.rest())
+	}
+	value := in.s[start : start+end]
+	if dot := strings.IndexByte(value, '.'); dot >= 0 {
+		digits := strings.IndexFunc(value[dot+1:], func(r rune) bool { return r < '0' || r > '9' })
+		if digits > 6 {
+			in.pos = start + dot + 7
+			return LogTimestamp{}, "expected at most six fraction digits"
+		}
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil || !strings.ContainsAny(value[10:11], "Tt") {
+		return LogTimestamp{}, `expected an RFC 3339 time such as "2003-10-11T22:14:15.003Z"`
+	}
+	in.pos = start + end
+	return LogTimestamp{Time: t, Start: start, End: in.pos}, ""
+}
+
+// readRFC3164Timestamp reads "<34>Oct 11 22:14:15" and infers the year.
+func readRFC3164Timestamp(in *tokenInput, o *LogTimestampOptions) (LogTimestamp, string) {
+	if in.pos < len(in.s) && in.s[in.pos] == '<' {
+		if reason := skipSyslogPriority(in); reason != "" {
+			return LogTimestamp{}, reason
+		}
+	}
+	start := in.pos
+	month, ok := in.name(LocaleEnUS.MonthsAbbreviated[:])
+	if !ok {
+		return LogTimestamp{}, "expected a month name such as Oct"
+	}
+	// The day is space-padded, but some senders write a single space.
+	if !in.literal(" ") {
+		return LogTimestamp{}, "expected a space after the month"
+	}
+	in.literal(" ")
+	day, ok := in.digits(1, 2)
+	if !ok {
+		return LogTimestamp{}, "expected the day of the month"
+	}
+	if !in.literal(" ") {
+		return LogTimestamp{}, "expected a space after the day"
+	}
+	hour, minute, second, reason := readRFCClock(in, false)
+	if reason != "" {
+		return LogTimestamp{}, reason
+	}
+	nanos, _ := in.fraction(".", 9)
+	t, ok := inferLogYear(time.Month(month+1), day, hour, minute, second, nanos, o)
+	if !ok {
+		in.pos = start
+		return LogTimestamp{}, fmt.Sprintf("day %d out of range for %s", day, time.Month(month+1))
+	}
+	return LogTimestamp{Time: t, Start: start, End: in.pos, YearInferred: true}, ""
+}
+
+// readCommonLogTimestamp reads "10/Oct/2000:13:55:36 -0700" from the first
+// bracketed field of the line, or from its start if it has none.
+func readCommonLogTimestamp(in *tokenInput) (LogTimestamp, string) {
+	bracketed := false
+	if open := strings.IndexByte(in.s, '['); open >= 0 {
+		in.pos = open + 1
+		bracketed = true
+	}
+	start := in.pos
+	day, ok := in.digits(2, 2)
+	if !ok {
+		return LogTimestamp{}, "expected a two-digit day"
+	}
+	if !in.literal("/") {
+		return LogTimestamp{}, `expected "/" after the day`
+	}
+	month, ok := in.name(LocaleEnUS.MonthsAbbreviated[:])
+	if !ok {
+		return LogTimestamp{}, "expected a month name such as Oct"
+	}
+	if !in.literal("/") {
+		return LogTimestamp{}, `expected "/" after the month`
+	}
+	year, ok := in.digits(4, 4)
+	if !ok {
+		return LogTimestamp{}, "expected a four-digit year"
+	}
+	if !in.literal(":") {
+		return LogTimestamp{}, `expected ":" after the year`
+	}
+	hour, minute, second, reason := readRFCClock(in, false)
+	if reason != "" {
+		return LogTimestamp{}, reason
+	}
+	if !in.literal(" ") {
+		return LogTimestamp{}, "expected a space before the offset"
+	}
+	if in.pos == len(in.s) || (in.s[in.pos] != '+' && in.s[in.pos] != '-') {
+		return LogTimestamp{}, `expected an offset such as "-0700"`
+	}
+	location, reason := readRFC2822Zone(in)
+	if reason != "" {
+		return LogTimestamp{}, reason
+	}
+	end := in.pos
+	if bracketed && !in.literal("]") {
+		return LogTimestamp{}, `expected "]" after the offset`
+	}
+	t, reason := rfcDate(year, month+1, day, hour, minute, second, -1, location)
+	if reason != "" {
+		in.pos = start
+		return LogTimestamp{}, reason
+	}
+	return LogTimestamp{Time: t, Start: start, End: end}, ""
+}
+
+// readLog4jTimestamp reads "2000-10-10 13:55:36,123", also accepting a
+// "T" separator, a "." before the fraction and a trailing offset.
+func readLog4jTimestamp(in *tokenInput, location *time.Location) (LogTimestamp, string) {
+	year, month, day, reason := readLogDate(in, '-')
+	if reason != "" {
+		return LogTimestamp{}, reason
+	}
+	if !in.literal(" ") && !in.literal("T") {
+		return LogTimestamp{}, `expected a space or "T" after the date`
+	}
+	hour, minute, second, reason := readRFCClock(in, false)
+	if reason != "" {
+		return LogTimestamp{}, reason
+	}
+	nanos, ok := in.fraction(",", 9)
+	if !ok {
+		nanos, _ = in.fraction(".", 9)
+	}
+	if in.pos < len(in.s) && strings.IndexByte("Z+-", in.s[in.pos]) >= 0 {
+		offset, err := in.strptimeOffset()
+		if err != nil {
+			return LogTimestamp{}, `expected an offset such as "+0100"`
+		}
+		location = time.FixedZone("", offset)
+	}
+	return finishLogTimestamp(in, year, month, day, hour, minute, second, nanos, location)
+}
+
+// readGoLogTimestamp reads "2009/01/23 01:23:23.123123".
+func readGoLogTimestamp(in *tokenInput, location *time.Location) (LogTimestamp, string) {
+	year, month, day, reason := readLogDate(in, '/')
+	if reason != "" {
+		return LogTimestamp{}, reason
+	}
+	if !in.literal(" ") {
+		return LogTimestamp{}, "expected a space after the date"
+	}
+	hour, minute, second, reason := readRFCClock(in, false)
+	if reason != "" {
+		return LogTimestamp{}, reason
+	}
+	nanos, _ := in.fraction(".", 6)
+	return finishLogTimestamp(in, year, month, day, hour, minute, second, nanos, location)
+}
+
+// readJavaLoggingTimestamp reads "Oct 10, 2000 1:55:36 PM".
+func readJavaLoggingTimestamp(in *tokenInput, location *time.Location) (LogTimestamp, string) {
+	month, ok := in.name(LocaleEnUS.MonthsAbbreviated[:])
+	if !ok {
+		return LogTimestamp{}, "expected a month name such as Oct"
+	}
+	if !in.literal(" ") {
+		return LogTimestamp{}, "expected a space after the month"
+	}
+	day, ok := in.digits(1, 2)
+	if !ok {
+		return LogTimestamp{}, "expected the day of the month"
+	}
+	if !in.literal(", ") {
+		return LogTimestamp{}, `expected ", " after the day`
+	}
+	year, ok := in.digits(4, 4)
+	if !ok {
+		return LogTimestamp{}, "expected a four-digit year"
+	}
+	if !in.literal(" ") {
+		return LogTimestamp{}, "expected a space after the year"
+	}
+	hour, ok := in.digits(1, 2)
+	if !ok || hour < 1 || hour > 12 {
+		return LogTimestamp{}, "expected the hour (1-12)"
+	}
+	if !in.literal(":") {
+		return LogTimestamp{}, `expected ":" after the hour`
+	}
+	minute, ok := in.digits(2, 2)
+	if !ok || minute > 59 {
+		return LogTimestamp{}, "expected the minute (00-59)"
+	}
+	if !in.literal(":") {
+		return LogTimestamp{}, `expected ":" after the minute`
+	}
+	second, ok := in.digits(2, 2)
+	if !ok || second > 60 {
+		return LogTimestamp{}, "expected the second (00-60)"
+	}
+	if !in.literal(" ") {
+		return LogTimestamp{}, "expected a space before AM or PM"
+	}
+	meridiem, ok := in.name([]string{"AM", "PM"})
+	if !ok {
+		return LogTimestamp{}, "expected AM or PM"
+	}
+	hour = hour%12 + 12*meridiem
+	return finishLogTimestamp(in, year, month+1, day, hour, minute, second, 0, location)
+}
+
+// readLogDate reads a yyyy-mm-dd date with the given separator.
+func readLogDate(in *tokenInput, separator byte) (year, month, day int, reason string) {
+	var ok bool
+	if year, ok = in.digits(4, 4); !ok {
+		return 0, 0, 0, "expected a four-digit year"
+	}
+	if !in.literal(string(separator)) {
+		return 0, 0, 0, fmt.Sprintf("expected %q after the year", separator)
+	}
+	if month, ok = in.digits(2, 2); !ok || month < 1 || month > 12 {
+		return 0, 0, 0, "expected the month (01-12)"
+	}
+	if !in.literal(string(separator)) {
+		return 0, 0, 0, fmt.Sprintf("expected %q after the month", separator)
+	}
+	if day, ok = in.digits(2, 2); !ok {
+		return 0, 0, 0, "expected a two-digit day"
+	}
+	return year, month, day, ""
+}
+
+// finishLogTimestamp builds a timestamp that starts at the beginning of
+// the line and ends at in.pos.
+func finishLogTimestamp(in *tokenInput, year, month, day, hour, minute, second, nanos int, location *time.Location) (LogTimestamp, string) {
+	t, reason := rfcDate(year, month, day, hour, minute, second, -1, location)
+	if reason != "" {
+		in.pos = 0
+		return LogTimestamp{}, reason
+	}
+	return LogTimestamp{Time: t.Add(time.Duration(nanos)), End: in.pos}, ""
+}
+
+// skipSyslogPriority consumes a "<PRI>" syslog header field.
+func skipSyslogPriority(in *tokenInput) string {
+	in.pos++
+	if priority, ok := in.digits(1, 3); !ok || priority > 191 {
+		return "expected a priority from 0 to 191"
+	}
+	if !in.literal(">") {
+		return `expected ">" after the priority`
+	}
+	return ""
+}
+
+// inferLogYear picks the latest year in which the date exists and is not
+// more than o.FutureTolerance after o.Reference.
+func inferLogYear(month time.Month, day, hour, minute, second, nanos int, o *LogTimestampOptions) (time.Time, bool) {
+	limit := o.Reference.Add(o.FutureTolerance)
+	// February 29 may need up to eight years to find a leap year.
+	for year := limit.Year(); year >= limit.Year()-8; year-- {
+		t := time.Date(year, month, day, hour, minute, second, nanos, o.Location)
+		if t.Month() == month && !t.After(limit) {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// fraction consumes separator and one to maxDigits digits, returning them
+// as nanoseconds.
+func (in *tokenInput) fraction(separator string, maxDigits int) (int, bool) {
+	start := in.pos
+	if !in.literal(separator) {
+		return 0, false
+	}
+	digitsStart := in.pos
+	value, ok := in.digits(1, maxDigits)
+	if !ok {
+		in.pos = start
+		return 0, false
+	}
+	for n := in.pos - digitsStart; n < 9; n++ {
+		value *= 10
+	}
+	return value, true
+}
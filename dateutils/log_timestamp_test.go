@@ -0,0 +1,179 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseLogTimestamp(t *testing.T) {
+	reference := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	pdt := time.FixedZone("", -7*3600)
+
+	tests := []struct {
+		name         string
+		line         string
+		want         time.Time
+		format       LogFormat
+		start, end   int
+		yearInferred bool
+	}{
+		{"RFC 5424", "<34>1 2003-10-11T22:14:15.003Z mymachine su - ID47 - 'su root' failed",
+			time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC), LogFormatRFC5424, 6, 30, false},
+		{"RFC 5424 with offset", "<165>1 2003-08-24T05:14:15.000003-07:00 192.0.2.1 myproc 8710 - - %% It's time",
+			time.Date(2003, 8, 24, 5, 14, 15, 3000, pdt), LogFormatRFC5424, 7, 39, false},
+		{"RFC 5424 without header", "2024-03-05T11:59:00Z host app",
+			time.Date(2024, 3, 5, 11, 59, 0, 0, time.UTC), LogFormatRFC5424, 0, 20, false},
+		{"RFC 3164", "<34>Feb 11 22:14:15 mymachine su: 'su root' failed",
+			time.Date(2024, 2, 11, 22, 14, 15, 0, time.UTC), LogFormatRFC3164, 4, 19, true},
+		{"RFC 3164 space-padded day", "Mar  1 08:00:00 host kernel: up",
+			time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC), LogFormatRFC3164, 0, 15, true},
+		{"RFC 3164 later in the year", "Oct 11 22:14:15 host cron: run",
+			time.Date(2023, 10, 11, 22, 14, 15, 0, time.UTC), LogFormatRFC3164, 0, 15, true},
+		{"RFC 3164 fraction", "Mar  5 11:59:59.250 host app: tick",
+			time.Date(2024, 3, 5, 11, 59, 59, 250000000, time.UTC), LogFormatRFC3164, 0, 19, true},
+		{"Common Log Format line", `127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326`,
+			time.Date(2000, 10, 10, 13, 55, 36, 0, pdt), LogFormatCommonLog, 19, 45, false},
+		{"Common Log Format time", "10/Oct/2000:13:55:36 -0700",
+			time.Date(2000, 10, 10, 13, 55, 36, 0, pdt), LogFormatCommonLog, 0, 26, false},
+		{"log4j", "2000-10-10 13:55:36,123 INFO  [main] App - started",
+			time.Date(2000, 10, 10, 13, 55, 36, 123000000, time.UTC), LogFormatLog4j, 0, 23, false},
+		{"log4j2 with T and dot", "2000-10-10T13:55:36.123 [main] INFO App",
+			time.Date(2000, 10, 10, 13, 55, 36, 123000000, time.UTC), LogFormatLog4j, 0, 23, false},
+		{"log4j with offset", "2000-10-10T13:55:36,123-0700 INFO",
+			time.Date(2000, 10, 10, 13, 55, 36, 123000000, pdt), LogFormatLog4j, 0, 28, false},
+		{"Go log", "2009/01/23 01:23:23 main.go:12: started",
+			time.Date(2009, 1, 23, 1, 23, 23, 0, time.UTC), LogFormatGoLog, 0, 19, false},
+		{"Go log with microseconds", "2009/01/23 01:23:23.123123 started",
+			time.Date(2009, 1, 23, 1, 23, 23, 123123000, time.UTC), LogFormatGoLog, 0, 26, false},
+		{"java.util.logging", "Oct 10, 2000 1:55:36 PM com.example.App main",
+			time.Date(2000, 10, 10, 13, 55, 36, 0, time.UTC), LogFormatJavaLogging, 0, 23, false},
+		{"java.util.logging midnight", "Oct 10, 2000 12:05:00 AM com.example.App main",
+			time.Date(2000, 10, 10, 0, 5, 0, 0, time.UTC), LogFormatJavaLogging, 0, 24, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLogTimestamp(tt.line, &LogTimestampOptions{Reference: reference})
+			if err != nil {
+				t.Fatalf("ParseLogTimestamp(%q) error = %v", tt.line, err)
+			}
+			if !got.Time.Equal(tt.want) {
+				t.Errorf("ParseLogTimestamp(%q) = %v, want %v", tt.line, got.Time, tt.want)
+			}
+			_, gotOffset := got.Time.Zone()
+			_, wantOffset := tt.want.Zone()
+			if gotOffset != wantOffset {
+				t.Errorf("ParseLogTimestamp(%q) offset = %d, want %d", tt.line, gotOffset, wantOffset)
+			}
+			if got.Format != tt.format {
+				t.Errorf("ParseLogTimestamp(%q) format = %s, want %s", tt.line, got.Format, tt.format)
+			}
+			if got.Start != tt.start || got.End != tt.end {
+				t.Errorf("ParseLogTimestamp(%q) span = %d-%d (%q), want %d-%d", tt.line, got.Start, got.End, tt.line[got.Start:got.End], tt.start, tt.end)
+			}
+			if got.YearInferred != tt.yearInferred {
+				t.Errorf("ParseLogTimestamp(%q) YearInferred = %v", tt.line, got.YearInferred)
+			}
+		})
+	}
+}
+
+func TestParseLogTimestampYearInference(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		reference time.Time
+		tolerance time.Duration
+		year      int
+	}{
+		{"December line read in January", "Dec 31 23:59:58 host app", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), 0, 2024},
+		{"January line read on December 31", "Jan  1 00:00:05 host app", time.Date(2024, 12, 31, 23, 59, 0, 0, time.UTC), 0, 2025},
+		{"Same day", "Mar  5 11:00:00 host app", time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC), 0, 2024},
+		{"Slightly ahead of the reference", "Mar  5 13:00:00 host app", time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC), 0, 2024},
+		{"Beyond a custom tolerance", "Mar  5 13:00:00 host app", time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC), time.Minute, 2023},
+		{"February 29", "Feb 29 10:00:00 host app", time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC), 0, 2024},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &LogTimestampOptions{Reference: tt.reference, FutureTolerance: tt.tolerance}
+			got, err := ParseLogTimestamp(tt.line, opts)
+			if err != nil {
+				t.Fatalf("ParseLogTimestamp(%q) error = %v", tt.line, err)
+			}
+			if got.Time.Year() != tt.year {
+				t.Errorf("ParseLogTimestamp(%q) = %v, want year %d", tt.line, got.Time, tt.year)
+			}
+		})
+	}
+}
+
+func TestParseLogTimestampOptions(t *testing.T) {
+	saoPaulo := time.FixedZone("BRT", -3*3600)
+	opts := &LogTimestampOptions{
+		Reference: time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC),
+		Location:  saoPaulo,
+	}
+	got, err := ParseLogTimestamp("2024/03/05 09:00:00 started", opts)
+	if err != nil {
+		t.Fatalf("ParseLogTimestamp() error = %v", err)
+	}
+	if want := time.Date(2024, 3, 5, 9, 0, 0, 0, saoPaulo); !got.Time.Equal(want) || got.Time.Location() != saoPaulo {
+		t.Errorf("ParseLogTimestamp() = %v, want %v", got.Time, want)
+	}
+
+	opts.Formats = []LogFormat{LogFormatCommonLog}
+	var parseErr *ParseError
+	if _, err := ParseLogTimestamp("2024/03/05 09:00:00 started", opts); !errors.As(err, &parseErr) || len(parseErr.Attempts) != 1 {
+		t.Errorf("ParseLogTimestamp() with Formats error = %v, want a *ParseError with one attempt", err)
+	}
+
+	opts.Formats = []LogFormat{LogFormat(42)}
+	if _, err := ParseLogTimestamp("2024/03/05 09:00:00 started", opts); err == nil {
+		t.Error("ParseLogTimestamp() with an unknown format error = nil")
+	}
+}
+
+func TestParseLogTimestampErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{"No timestamp", "hello world"},
+		{"RFC 5424 nil timestamp", "<34>1 - host app - - msg"},
+		{"RFC 5424 too many fraction digits", "<34>1 2003-10-11T22:14:15.0000003Z host"},
+		{"Priority out of range", "<192>Oct 11 22:14:15 host app"},
+		{"Invalid day", "Feb 30 22:14:15 host app"},
+		{"Invalid month", "2024/13/05 09:00:00 started"},
+		{"Common Log Format without offset", "[10/Oct/2000:13:55:36] GET"},
+		{"Hour out of range", "2024-03-05 25:00:00,000 INFO"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var parseErr *ParseError
+			if _, err := ParseLogTimestamp(tt.line, nil); !errors.As(err, &parseErr) {
+				t.Errorf("ParseLogTimestamp(%q) error = %v, want a *ParseError", tt.line, err)
+			}
+		})
+	}
+
+	if _, err := ParseLogTimestamp("  ", nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("ParseLogTimestamp(\"  \") error = %v, want ErrEmptyInput", err)
+	}
+}
+
+func TestLogFormatString(t *testing.T) {
+	if got := LogFormatCommonLog.String(); got != "Common Log Format" {
+		t.Errorf("LogFormatCommonLog.String() = %q", got)
+	}
+	if got := LogFormat(42).String(); got != "LogFormat(42)" {
+		t.Errorf("LogFormat(42).String() = %q", got)
+	}
+}
+
+func BenchmarkParseLogTimestamp(b *testing.B) {
+	opts := &LogTimestampOptions{Reference: time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)}
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseLogTimestamp("<34>Oct 11 22:14:15 mymachine su: 'su root' failed", opts)
+	}
+}
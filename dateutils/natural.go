@@ -0,0 +1,684 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// naturalLayout is the layout name reported in ParseNatural errors.
+const naturalLayout = "natural language"
+
+// NaturalGrammar holds the words and phrases ParseNatural understands in one
+// language. Phrases are matched word by word, case- and accent-insensitively,
+// with hyphens, apostrophes and dots ignored, so "meio-dia" also matches
+// "meio dia" and "a.m." matches "a m". The longest phrase wins.
+type NaturalGrammar struct {
+	Now                []string // "now"
+	Today              []string // "today"
+	Tomorrow           []string // "tomorrow"
+	Yesterday          []string // "yesterday"
+	DayAfterTomorrow   []string // "the day after tomorrow"
+	DayBeforeYesterday []string // "the day before yesterday"
+
+	// Next, Last and This come before a weekday or unit: "next friday",
+	// "last month", "this week". NextAfter and LastAfter come after it, as
+	// in Portuguese "sexta que vem" and "mês passado".
+	Next, Last, This     []string
+	NextAfter, LastAfter []string
+
+	// FutureBefore and FutureAfter surround an amount of time in the future:
+	// "in 2 weeks", "2 weeks from now". PastBefore and PastAfter do the same
+	// in the past: "há 3 dias", "3 days ago".
+	FutureBefore, FutureAfter []string
+	PastBefore, PastAfter     []string
+	And                       []string // Joins amounts: "1 hour and 30 minutes"
+
+	StartOf, EndOf []string // Before a unit: "start of", "end of the"
+
+	// Unit names, singular and plural.
+	Years, Quarters, Months, Weeks, Days, Hours, Minutes, Seconds []string
+
+	// Numbers maps number words to their values: "a", "one", "two".
+	Numbers map[string]int
+
+	// Weekdays holds weekday names beyond the Locale's wide and abbreviated
+	// names, indexed by time.Weekday: "tues", "sexta".
+	Weekdays [7][]string
+
+	Noon, Midnight []string // "noon", "midnight"
+	AM, PM         []string // After an hour: "am", "p.m.", "da tarde"
+	At             []string // Before a time: "at", "às"
+	HourMark       []string // Between hours and minutes: "h" in "17h30"
+}
+
+// empty reports whether the grammar has no phrases for the basic words.
+func (g *NaturalGrammar) empty() bool {
+	return len(g.Today) == 0 && len(g.Next) == 0 && len(g.FutureBefore) == 0
+}
+
+// NaturalOptions configures ParseNatural.
+type NaturalOptions struct {
+	// Locale provides the NaturalGrammar, the weekday names and the first
+	// day of the week. Nil means LocaleEnUS.
+	Locale *Locale
+
+	// Strict requires the whole input to be a date expression, instead of
+	// finding the first one in a longer text.
+	Strict bool
+}
+
+// NaturalResult is a date expression found by ParseNatural.
+type NaturalResult struct {
+	Time       time.Time
+	Start, End int       // Byte offsets of the expression in the input
+	Precision  Precision // Finest component the expression states
+}
+
+// ParseNatural parses a date written in everyday language, relative to ref,
+// and returns the time with the span of the input it was read from. The
+// first expression in the input is used, so "remind me next friday at 5pm
+// to call" works; set opts.Strict to reject any other text.
+//
+// An expression is a date, a time of day, or both, in either order:
+//   - days: "now", "today", "tomorrow", "the day after tomorrow";
+//   - weekdays: "next tuesday" (NextDay), "last fri" (PreviousDay), and
+//     "friday" or "this friday", which is today on a Friday;
+//   - units: "next week", "last month", "this quarter";
+//   - offsets: "in 2 weeks", "3 days ago", "1 hour and 30 minutes from now";
+//   - anchors: "start of next month", "end of quarter", "end of the week",
+//     with weeks starting on the Locale's WeekStartsOn;
+//   - times: "noon", "midnight", "5pm", "5:30 p.m.", "17:30", "at 17:30:15".
+//
+// Dates keep the clock time of ref unless a time is given; anchors return
+// the first or last instant of the period. Precision reports the finest
+// component stated: PrecisionDay for "tomorrow", PrecisionWeek for "next
+// week", PrecisionMinute for "tomorrow at 17:30".
+//
+// The words come from opts.Locale.Natural; LocaleEnUS, LocaleEnGB,
+// LocalePtBR and LocalePtPT have grammars, so "próxima sexta às 17h",
+// "daqui a 2 semanas" and "fim do mês que vem" work with the Portuguese ones.
+// Returns a *ParseError if no expression is found, an error wrapping
+// ErrEmptyInput for an empty input, or an error wrapping
+// errors.ErrUnsupported if the Locale has no grammar.
+//
+// Example:
+//
+//	ref := time.Date(2024, 3, 6, 9, 0, 0, 0, time.UTC) // a Wednesday
+//	r, _ := ParseNatural("next friday 5pm", ref, nil)
+//	// r.Time is 2024-03-08 17:00, r.Precision is PrecisionHour
+//	r, _ = ParseNatural("fim do mês que vem", ref, &NaturalOptions{Locale: LocalePtBR})
+//	// r.Time is 2024-04-30 23:59:59.999999999
+func ParseNatural(input string, ref time.Time, opts *NaturalOptions) (NaturalResult, error) {
+	if strings.TrimSpace(input) == "" {
+		return NaturalResult{}, fmt.Errorf("cannot parse date string: %w", ErrEmptyInput)
+	}
+	var o NaturalOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Locale == nil {
+		o.Locale = LocaleEnUS
+	}
+	if o.Locale.Natural.empty() {
+		return NaturalResult{}, fmt.Errorf("locale %s has no natural-language grammar: %w", o.Locale.Code, errors.ErrUnsupported)
+	}
+
+	p := &naturalParser{
+		g:     compiledNaturalGrammar(o.Locale),
+		items: splitNaturalItems(input),
+		ref:   ref,
+		week:  o.Locale.WeekStartsOn,
+	}
+	fail := func(offset int, reason string) (NaturalResult, error) {
+		return NaturalResult{}, newParseError(input, []LayoutAttempt{{Layout: naturalLayout, Offset: offset, Err: errors.New(reason)}})
+	}
+
+	for i := range p.items {
+		t, precision, end, ok := p.expression(i)
+		if !ok {
+			if o.Strict {
+				return fail(p.items[i].start, "expected a date or time")
+			}
+			continue
+		}
+		if o.Strict && end < len(p.items) {
+			return fail(p.items[end].start, "unexpected text after the date")
+		}
+		return NaturalResult{Time: t, Start: p.items[i].start, End: p.items[end-1].end, Precision: precision}, nil
+	}
+	return fail(0, "no date or time found")
+}
+
+// naturalUnit is a unit of time in a natural-language expression.
+type naturalUnit int
+
+const (
+	naturalYear naturalUnit = iota
+	naturalQuarter
+	naturalMonth
+	naturalWeek
+	naturalDay
+	naturalHour
+	naturalMinute
+	naturalSecond
+	naturalUnitCount
+)
+
+// precision returns the precision of an offset in the unit; weeks are a
+// number of days.
+func (u naturalUnit) precision() Precision {
+	return [...]Precision{PrecisionYear, PrecisionMonth, PrecisionMonth, PrecisionDay,
+		PrecisionDay, PrecisionHour, PrecisionMinute, PrecisionSecond}[u]
+}
+
+// add moves t by n units.
+func (u naturalUnit) add(t time.Time, n int) time.Time {
+	switch u {
+	case naturalYear:
+		return AddYears(t, n)
+	case naturalQuarter:
+		return AddMonths(t, 3*n)
+	case naturalMonth:
+		return AddMonths(t, n)
+	case naturalWeek:
+		return AddDays(t, 7*n)
+	case naturalDay:
+		return AddDays(t, n)
+	case naturalHour:
+		return AddHours(t, n)
+	case naturalMinute:
+		return AddMinutes(t, n)
+	default:
+		return AddSeconds(t, n)
+	}
+}
+
+// phrases is a list of phrases split into folded words.
+type phrases [][]string
+
+// naturalGrammar is a NaturalGrammar with its phrases split and folded.
+type naturalGrammar struct {
+	now, today, tomorrow, yesterday, dayAfter, dayBefore phrases
+	next, last, this, nextAfter, lastAfter               phrases
+	futureBefore, futureAfter, pastBefore, pastAfter     phrases
+	and, startOf, endOf                                  phrases
+	units                                                [naturalUnitCount]phrases
+	numbers                                              map[string]int
+	weekdays                                             [7]phrases
+	noon, midnight, am, pm, at, hourMark                 phrases
+}
+
+// naturalGrammars caches the compiled grammar of each Locale.
+var naturalGrammars sync.Map // *Locale -> *naturalGrammar
+
+// compiledNaturalGrammar returns the compiled grammar of a Locale.
+func compiledNaturalGrammar(locale *Locale) *naturalGrammar {
+	if g, ok := naturalGrammars.Load(locale); ok {
+		return g.(*naturalGrammar)
+	}
+	src := &locale.Natural
+	g := &naturalGrammar{
+		now: splitPhrases(src.Now), today: splitPhrases(src.Today),
+		tomorrow: splitPhrases(src.Tomorrow), yesterday: splitPhrases(src.Yesterday),
+		dayAfter: splitPhrases(src.DayAfterTomorrow), dayBefore: splitPhrases(src.DayBeforeYesterday),
+		next: splitPhrases(src.Next), last: splitPhrases(src.Last), this: splitPhrases(src.This),
+		nextAfter: splitPhrases(src.NextAfter), lastAfter: splitPhrases(src.LastAfter),
+		futureBefore: splitPhrases(src.FutureBefore), futureAfter: splitPhrases(src.FutureAfter),
+		pastBefore: splitPhrases(src.PastBefore), pastAfter: splitPhrases(src.PastAfter),
+		and: splitPhrases(src.And), startOf: splitPhrases(src.StartOf), endOf: splitPhrases(src.EndOf),
+		numbers: map[string]int{},
+		noon:    splitPhrases(src.Noon), midnight: splitPhrases(src.Midnight),
+		am: splitPhrases(src.AM), pm: splitPhrases(src.PM),
+		at: splitPhrases(src.At), hourMark: splitPhrases(src.HourMark),
+	}
+	for u, names := range [...][]string{src.Years, src.Quarters, src.Months, src.Weeks,
+		src.Days, src.Hours, src.Minutes, src.Seconds} {
+		g.units[u] = splitPhrases(names)
+	}
+	for word, value := range src.Numbers {
+		g.numbers[foldName(word)] = value
+	}
+	for d := 0; d < 7; d++ {
+		names := append([]string{locale.WeekdaysWide[d], locale.WeekdaysAbbreviated[d]}, src.Weekdays[d]...)
+		g.weekdays[d] = splitPhrases(names)
+	}
+	actual, _ := naturalGrammars.LoadOrStore(locale, g)
+	return actual.(*naturalGrammar)
+}
+
+// splitPhrases splits each phrase into folded words, dropping empty ones.
+func splitPhrases(list []string) phrases {
+	out := make(phrases, 0, len(list))
+	for _, phrase := range list {
+		var words []string
+		for _, item := range splitNaturalItems(phrase) {
+			words = append(words, item.text)
+		}
+		if len(words) > 0 {
+			out = append(out, words)
+		}
+	}
+	return out
+}
+
+// naturalItem is a folded word, a number or a colon of the input.
+type naturalItem struct {
+	text       string
+	number     bool
+	start, end int
+}
+
+// splitNaturalItems splits s into runs of letters, runs of digits and
+// colons; all other characters separate items.
+func splitNaturalItems(s string) []naturalItem {
+	var items []naturalItem
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		start := i
+		switch {
+		case r >= '0' && r <= '9':
+			for i < len(s) && isDigit(s[i]) {
+				i++
+			}
+			items = append(items, naturalItem{text: s[start:i], number: true, start: start, end: i})
+		case unicode.IsLetter(r) || r == 'º' || r == 'ª':
+			for i < len(s) {
+				c, n := utf8.DecodeRuneInString(s[i:])
+				if !unicode.IsLetter(c) && !unicode.Is(unicode.Mn, c) && c != 'º' && c != 'ª' {
+					break
+				}
+				i += n
+			}
+			items = append(items, naturalItem{text: foldName(s[start:i]), start: start, end: i})
+		case r == ':':
+			i++
+			items = append(items, naturalItem{text: ":", start: start, end: i})
+		default:
+			i += size
+		}
+	}
+	return items
+}
+
+// naturalParser reads expressions from the items of one input.
+type naturalParser struct {
+	g     *naturalGrammar
+	items []naturalItem
+	ref   time.Time
+	week  time.Weekday
+}
+
+// match returns the position after the longest phrase starting at item i.
+func (p *naturalParser) match(i int, list phrases) (int, bool) {
+	best := -1
+	for _, phrase := range list {
+		if len(phrase) <= best-i || i+len(phrase) > len(p.items) {
+			continue
+		}
+		matched := true
+		for k, word := range phrase {
+			if item := p.items[i+k]; item.number || item.text != word {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			best = i + len(phrase)
+		}
+	}
+	return best, best >= 0
+}
+
+// expression reads a date, a time or both at item i and returns the time,
+// its precision and the position after it.
+func (p *naturalParser) expression(i int) (time.Time, Precision, int, bool) {
+	date, datePrecision := p.ref, Precision(0)
+	hasDate, hasClock := false, false
+	var hour, minute, second int
+	var clockPrecision Precision
+	j := i
+	for {
+		if !hasDate {
+			if t, prec, next, ok := p.date(j); ok {
+				date, datePrecision, hasDate, j = t, prec, true, next
+				continue
+			}
+		}
+		if !hasClock {
+			if h, m, s, prec, next, ok := p.clock(j); ok {
+				hour, minute, second, clockPrecision, hasClock, j = h, m, s, prec, true, next
+				continue
+			}
+		}
+		break
+	}
+	if j == i {
+		return time.Time{}, 0, 0, false
+	}
+	if hasClock {
+		date = time.Date(date.Year(), date.Month(), date.Day(), hour, minute, second, 0, date.Location())
+		return date, clockPrecision, j, true
+	}
+	return date, datePrecision, j, true
+}
+
+// date reads a day, weekday, unit, offset or anchor at item i.
+func (p *naturalParser) date(i int) (time.Time, Precision, int, bool) {
+	if j, ok := p.match(i, p.g.now); ok {
+		return p.ref, PrecisionSecond, j, true
+	}
+	for _, day := range []struct {
+		list phrases
+		days int
+	}{{p.g.today, 0}, {p.g.tomorrow, 1}, {p.g.yesterday, -1}, {p.g.dayAfter, 2}, {p.g.dayBefore, -2}} {
+		if j, ok := p.match(i, day.list); ok {
+			return AddDays(p.ref, day.days), PrecisionDay, j, true
+		}
+	}
+
+	for _, anchor := range []struct {
+		list  phrases
+		start bool
+	}{{p.g.startOf, true}, {p.g.endOf, false}} {
+		j, ok := p.match(i, anchor.list)
+		if !ok {
+			continue
+		}
+		unit, shift, next, ok := p.unitReference(j, true)
+		if !ok || unit > naturalHour {
+			return time.Time{}, 0, 0, false
+		}
+		t := p.anchor(unit.add(p.ref, shift), unit, anchor.start)
+		if unit == naturalHour {
+			return t, PrecisionHour, next, true
+		}
+		return t, PrecisionDay, next, true
+	}
+
+	if weekday, shift, j, ok := p.weekdayReference(i); ok {
+		var t time.Time
+		switch {
+		case shift > 0:
+			t = NextDay(p.ref, weekday)
+		case shift < 0:
+			t = PreviousDay(p.ref, weekday)
+		case p.ref.Weekday() == weekday:
+			t = p.ref
+		default:
+			t = NextDay(p.ref, weekday)
+		}
+		return t, PrecisionDay, j, true
+	}
+
+	if unit, shift, j, ok := p.unitReference(i, false); ok {
+		precision := unit.precision()
+		if unit == naturalWeek {
+			precision = PrecisionWeek
+		}
+		return unit.add(p.ref, shift), precision, j, true
+	}
+
+	return p.offset(i)
+}
+
+// modifier reads a Next, Last or This phrase before a weekday or unit and
+// returns its shift.
+func (p *naturalParser) modifier(i int) (int, int, bool) {
+	for _, m := range []struct {
+		list  phrases
+		shift int
+	}{{p.g.next, 1}, {p.g.last, -1}, {p.g.this, 0}} {
+		if j, ok := p.match(i, m.list); ok {
+			return m.shift, j, true
+		}
+	}
+	return 0, i, false
+}
+
+// modifierAfter reads a NextAfter or LastAfter phrase after a weekday or unit.
+func (p *naturalParser) modifierAfter(i int) (int, int, bool) {
+	if j, ok := p.match(i, p.g.nextAfter); ok {
+		return 1, j, true
+	}
+	if j, ok := p.match(i, p.g.lastAfter); ok {
+		return -1, j, true
+	}
+	return 0, i, false
+}
+
+// weekdayReference reads a weekday with an optional modifier before or after it.
+func (p *naturalParser) weekdayReference(i int) (time.Weekday, int, int, bool) {
+	shift, j, hasModifier := p.modifier(i)
+	for d, list := range p.g.weekdays {
+		k, ok := p.match(j, list)
+		if !ok {
+			continue
+		}
+		if !hasModifier {
+			if after, next, ok := p.modifierAfter(k); ok {
+				shift, k = after, next
+			}
+		}
+		return time.Weekday(d), shift, k, true
+	}
+	return 0, 0, 0, false
+}
+
+// unitReference reads a unit with a modifier before or after it; bare
+// units are accepted after an anchor phrase, as in "end of month".
+func (p *naturalParser) unitReference(i int, bare bool) (naturalUnit, int, int, bool) {
+	shift, j, hasModifier := p.modifier(i)
+	for u, list := range p.g.units {
+		k, ok := p.match(j, list)
+		if !ok {
+			continue
+		}
+		if !hasModifier {
+			after, next, ok := p.modifierAfter(k)
+			if !ok && !bare {
+				return 0, 0, 0, false
+			}
+			shift, k = after, next
+		}
+		return naturalUnit(u), shift, k, true
+	}
+	return 0, 0, 0, false
+}
+
+// anchor returns the start or end of the unit containing t.
+func (p *naturalParser) anchor(t time.Time, unit naturalUnit, start bool) time.Time {
+	switch unit {
+	case naturalYear:
+		if start {
+			return StartOfYear(t)
+		}
+		return EndOfYear(t)
+	case naturalQuarter:
+		if start {
+			return StartOfQuarter(t)
+		}
+		return EndOfQuarter(t)
+	case naturalMonth:
+		if start {
+			return StartOfMonth(t)
+		}
+		return EndOfMonth(t)
+	case naturalWeek:
+		first := AddDays(StartOfDay(t), -((int(t.Weekday()) - int(p.week) + 7) % 7))
+		if start {
+			return first
+		}
+		return EndOfDay(AddDays(first, 6))
+	case naturalDay:
+		if start {
+			return StartOfDay(t)
+		}
+		return EndOfDay(t)
+	default:
+		if start {
+			return StartOfHour(t)
+		}
+		return EndOfHour(t)
+	}
+}
+
+// offset reads amounts of time with a past or future phrase before or
+// after them: "in 2 weeks", "3 days ago".
+func (p *naturalParser) offset(i int) (time.Time, Precision, int, bool) {
+	sign := 0
+	j := i
+	if next, ok := p.match(i, p.g.futureBefore); ok {
+		sign, j = 1, next
+	} else if next, ok := p.match(i, p.g.pastBefore); ok {
+		sign, j = -1, next
+	}
+
+	type amount struct {
+		unit naturalUnit
+		n    int
+	}
+	var amounts []amount
+	for {
+		k := j
+		if len(amounts) > 0 {
+			if next, ok := p.match(k, p.g.and); ok {
+				k = next
+			}
+		}
+		n, next, ok := p.number(k)
+		if !ok {
+			break
+		}
+		unit, next, ok := p.unit(next)
+		if !ok {
+			break
+		}
+		amounts = append(amounts, amount{unit, n})
+		j = next
+	}
+	if len(amounts) == 0 {
+		return time.Time{}, 0, 0, false
+	}
+	if sign == 0 {
+		if next, ok := p.match(j, p.g.futureAfter); ok {
+			sign, j = 1, next
+		} else if next, ok := p.match(j, p.g.pastAfter); ok {
+			sign, j = -1, next
+		} else {
+			return time.Time{}, 0, 0, false
+		}
+	}
+
+	t, precision := p.ref, PrecisionYear
+	for _, a := range amounts {
+		t = a.unit.add(t, sign*a.n)
+		precision = max(precision, a.unit.precision())
+	}
+	return t, precision, j, true
+}
+
+// number reads digits or a number word.
+func (p *naturalParser) number(i int) (int, int, bool) {
+	if i >= len(p.items) {
+		return 0, i, false
+	}
+	item := p.items[i]
+	if item.number {
+		if len(item.text) > 6 {
+			return 0, i, false
+		}
+		n := 0
+		for _, c := range []byte(item.text) {
+			n = n*10 + int(c-'0')
+		}
+		return n, i + 1, true
+	}
+	if n, ok := p.g.numbers[item.text]; ok {
+		return n, i + 1, true
+	}
+	return 0, i, false
+}
+
+// unit reads a unit name.
+func (p *naturalParser) unit(i int) (naturalUnit, int, bool) {
+	for u, list := range p.g.units {
+		if j, ok := p.match(i, list); ok {
+			return naturalUnit(u), j, true
+		}
+	}
+	return 0, i, false
+}
+
+// clock reads a time of day: "noon", "5pm", "17:30", "17h30", "at 5:30 am".
+func (p *naturalParser) clock(i int) (hour, minute, second int, precision Precision, end int, ok bool) {
+	j := i
+	if next, ok := p.match(i, p.g.at); ok {
+		j = next
+	}
+	if next, ok := p.match(j, p.g.noon); ok {
+		return 12, 0, 0, PrecisionMinute, next, true
+	}
+	if next, ok := p.match(j, p.g.midnight); ok {
+		return 0, 0, 0, PrecisionMinute, next, true
+	}
+
+	if j >= len(p.items) || !p.items[j].number || len(p.items[j].text) > 2 {
+		return 0, 0, 0, 0, i, false
+	}
+	hour, _, _ = p.number(j)
+	j++
+	precision = PrecisionHour
+	marked := false
+	switch {
+	case p.colonNumber(j):
+		minute, _, _ = p.number(j + 1)
+		j += 2
+		precision = PrecisionMinute
+		if p.colonNumber(j) {
+			second, _, _ = p.number(j + 1)
+			j += 2
+			precision = PrecisionSecond
+		}
+		marked = true
+	default:
+		if next, ok := p.match(j, p.g.hourMark); ok {
+			j, marked = next, true
+			if j < len(p.items) && p.items[j].number && len(p.items[j].text) == 2 {
+				minute, _, _ = p.number(j)
+				j++
+				precision = PrecisionMinute
+			}
+		}
+	}
+	if minute > 59 || second > 59 {
+		return 0, 0, 0, 0, i, false
+	}
+
+	if next, ok := p.match(j, p.g.am); ok {
+		if hour < 1 || hour > 12 {
+			return 0, 0, 0, 0, i, false
+		}
+		return hour % 12, minute, second, precision, next, true
+	}
+	if next, ok := p.match(j, p.g.pm); ok {
+		if hour < 1 || hour > 12 {
+			return 0, 0, 0, 0, i, false
+		}
+		return hour%12 + 12, minute, second, precision, next, true
+	}
+	if !marked || hour > 23 {
+		return 0, 0, 0, 0, i, false
+	}
+	return hour, minute, second, precision, j, true
+}
+
+// colonNumber reports whether item i is a colon followed by two digits.
+func (p *naturalParser) colonNumber(i int) bool {
+	return i+1 < len(p.items) && p.items[i].text == ":" &&
+		p.items[i+1].number && len(p.items[i+1].text) == 2
+}
@@ -0,0 +1,188 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// naturalRef is Wednesday, March 6, 2024, 09:00 UTC.
+var naturalRef = time.Date(2024, time.March, 6, 9, 0, 0, 0, time.UTC)
+
+func TestParseNatural(t *testing.T) {
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+	}
+	end := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+
+	tests := []struct {
+		input     string
+		want      time.Time
+		precision Precision
+	}{
+		{"now", naturalRef, PrecisionSecond},
+		{"today", naturalRef, PrecisionDay},
+		{"Tomorrow", at(time.March, 7, 9, 0), PrecisionDay},
+		{"yesterday", at(time.March, 5, 9, 0), PrecisionDay},
+		{"the day after tomorrow", at(time.March, 8, 9, 0), PrecisionDay},
+		{"next friday", at(time.March, 8, 9, 0), PrecisionDay},
+		{"next wednesday", at(time.March, 13, 9, 0), PrecisionDay},
+		{"last fri", at(time.March, 1, 9, 0), PrecisionDay},
+		{"wednesday", naturalRef, PrecisionDay},
+		{"this thurs", at(time.March, 7, 9, 0), PrecisionDay},
+		{"next week", at(time.March, 13, 9, 0), PrecisionWeek},
+		{"last month", at(time.February, 6, 9, 0), PrecisionMonth},
+		{"next year", time.Date(2025, 3, 6, 9, 0, 0, 0, time.UTC), PrecisionYear},
+		{"in 2 weeks", at(time.March, 20, 9, 0), PrecisionDay},
+		{"3 days ago", at(time.March, 3, 9, 0), PrecisionDay},
+		{"a week ago", at(time.February, 28, 9, 0), PrecisionDay},
+		{"in 1 hour and 30 minutes", at(time.March, 6, 10, 30), PrecisionMinute},
+		{"2 months from now", at(time.May, 6, 9, 0), PrecisionMonth},
+		{"in 5m", at(time.March, 6, 9, 5), PrecisionMinute},
+		{"start of next month", at(time.April, 1, 0, 0), PrecisionDay},
+		{"end of month", end(time.March, 31), PrecisionDay},
+		{"end of quarter", end(time.March, 31), PrecisionDay},
+		{"beginning of next quarter", at(time.April, 1, 0, 0), PrecisionDay},
+		{"end of the week", end(time.March, 9), PrecisionDay},
+		{"start of year", at(time.January, 1, 0, 0), PrecisionDay},
+		{"noon", at(time.March, 6, 12, 0), PrecisionMinute},
+		{"midnight", at(time.March, 6, 0, 0), PrecisionMinute},
+		{"5pm", at(time.March, 6, 17, 0), PrecisionHour},
+		{"12am", at(time.March, 6, 0, 0), PrecisionHour},
+		{"5:30 p.m. today", at(time.March, 6, 17, 30), PrecisionMinute},
+		{"17:30", at(time.March, 6, 17, 30), PrecisionMinute},
+		{"at 17:30:15", time.Date(2024, 3, 6, 17, 30, 15, 0, time.UTC), PrecisionSecond},
+		{"next friday 5pm", at(time.March, 8, 17, 0), PrecisionHour},
+		{"5pm next friday", at(time.March, 8, 17, 0), PrecisionHour},
+		{"tomorrow at noon", at(time.March, 7, 12, 0), PrecisionMinute},
+		{"in 2 days at 9:15am", at(time.March, 8, 9, 15), PrecisionMinute},
+		{"end of next month at 18:00", at(time.April, 30, 18, 0), PrecisionMinute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseNatural(tt.input, naturalRef, nil)
+			if err != nil {
+				t.Fatalf("ParseNatural(%q) error = %v", tt.input, err)
+			}
+			if !got.Time.Equal(tt.want) {
+				t.Errorf("ParseNatural(%q) = %v, want %v", tt.input, got.Time, tt.want)
+			}
+			if got.Precision != tt.precision {
+				t.Errorf("ParseNatural(%q) precision = %s, want %s", tt.input, got.Precision, tt.precision)
+			}
+			if got.Start != 0 || got.End != len(tt.input) {
+				t.Errorf("ParseNatural(%q) span = %d-%d, want the whole input", tt.input, got.Start, got.End)
+			}
+		})
+	}
+}
+
+func TestParseNaturalPortuguese(t *testing.T) {
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		input     string
+		want      time.Time
+		precision Precision
+	}{
+		{"agora", naturalRef, PrecisionSecond},
+		{"amanhã", at(time.March, 7, 9, 0), PrecisionDay},
+		{"AMANHA", at(time.March, 7, 9, 0), PrecisionDay},
+		{"depois de amanhã", at(time.March, 8, 9, 0), PrecisionDay},
+		{"anteontem", at(time.March, 4, 9, 0), PrecisionDay},
+		{"próxima sexta às 17h", at(time.March, 8, 17, 0), PrecisionHour},
+		{"sexta que vem às 9h30", at(time.March, 8, 9, 30), PrecisionMinute},
+		{"segunda-feira passada", at(time.March, 4, 9, 0), PrecisionDay},
+		{"na quinta", at(time.March, 7, 9, 0), PrecisionDay},
+		{"semana que vem", at(time.March, 13, 9, 0), PrecisionWeek},
+		{"mês passado", at(time.February, 6, 9, 0), PrecisionMonth},
+		{"daqui a 2 semanas", at(time.March, 20, 9, 0), PrecisionDay},
+		{"em duas horas", at(time.March, 6, 11, 0), PrecisionHour},
+		{"há 3 dias", at(time.March, 3, 9, 0), PrecisionDay},
+		{"3 dias atrás", at(time.March, 3, 9, 0), PrecisionDay},
+		{"fim do mês que vem", time.Date(2024, 4, 30, 23, 59, 59, 999999999, time.UTC), PrecisionDay},
+		{"início da próxima semana", at(time.March, 10, 0, 0), PrecisionDay},
+		{"amanhã ao meio-dia", at(time.March, 7, 12, 0), PrecisionMinute},
+		{"hoje às 5 da tarde", at(time.March, 6, 17, 0), PrecisionHour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseNatural(tt.input, naturalRef, &NaturalOptions{Locale: LocalePtBR})
+			if err != nil {
+				t.Fatalf("ParseNatural(%q) error = %v", tt.input, err)
+			}
+			if !got.Time.Equal(tt.want) {
+				t.Errorf("ParseNatural(%q) = %v, want %v", tt.input, got.Time, tt.want)
+			}
+			if got.Precision != tt.precision {
+				t.Errorf("ParseNatural(%q) precision = %s, want %s", tt.input, got.Precision, tt.precision)
+			}
+		})
+	}
+}
+
+func TestParseNaturalSpan(t *testing.T) {
+	input := "remind me next tuesday at 9:30am to call"
+	got, err := ParseNatural(input, naturalRef, nil)
+	if err != nil {
+		t.Fatalf("ParseNatural() error = %v", err)
+	}
+	if span := input[got.Start:got.End]; span != "next tuesday at 9:30am" {
+		t.Errorf("ParseNatural() span = %q", span)
+	}
+	if want := time.Date(2024, 3, 12, 9, 30, 0, 0, time.UTC); !got.Time.Equal(want) {
+		t.Errorf("ParseNatural() = %v, want %v", got.Time, want)
+	}
+
+	var parseErr *ParseError
+	if _, err := ParseNatural(input, naturalRef, &NaturalOptions{Strict: true}); !errors.As(err, &parseErr) || parseErr.Offset != 0 {
+		t.Errorf("ParseNatural(strict) error = %v, want a *ParseError at offset 0", err)
+	}
+	if _, err := ParseNatural("tomorrow please", naturalRef, &NaturalOptions{Strict: true}); !errors.As(err, &parseErr) || parseErr.Offset != 9 {
+		t.Errorf("ParseNatural(strict) error = %v, want a *ParseError at offset 9", err)
+	}
+}
+
+func TestParseNaturalCustomGrammar(t *testing.T) {
+	locale := *LocaleES
+	locale.Natural = NaturalGrammar{
+		Today:    []string{"hoy"},
+		Tomorrow: []string{"mañana"},
+		Next:     []string{"próximo", "próxima"},
+		Weekdays: [7][]string{time.Friday: {"viernes"}},
+	}
+	got, err := ParseNatural("el próximo viernes", naturalRef, &NaturalOptions{Locale: &locale})
+	if err != nil {
+		t.Fatalf("ParseNatural() error = %v", err)
+	}
+	if want := time.Date(2024, 3, 8, 9, 0, 0, 0, time.UTC); !got.Time.Equal(want) {
+		t.Errorf("ParseNatural() = %v, want %v", got.Time, want)
+	}
+}
+
+func TestParseNaturalErrors(t *testing.T) {
+	var parseErr *ParseError
+	for _, input := range []string{"hello world", "in 2", "25:00", "13pm", "3 days"} {
+		if _, err := ParseNatural(input, naturalRef, nil); !errors.As(err, &parseErr) {
+			t.Errorf("ParseNatural(%q) error = %v, want a *ParseError", input, err)
+		}
+	}
+	if _, err := ParseNatural(" ", naturalRef, nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("ParseNatural(\" \") error = %v, want ErrEmptyInput", err)
+	}
+	if _, err := ParseNatural("demain", naturalRef, &NaturalOptions{Locale: LocaleFR}); !errors.Is(err, errors.ErrUnsupported) {
+		t.Errorf("ParseNatural(fr) error = %v, want errors.ErrUnsupported", err)
+	}
+}
+
+func BenchmarkParseNatural(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseNatural("remind me next friday at 5:30pm", naturalRef, nil)
+	}
+}
@@ -0,0 +1,178 @@
+package dateutils
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NumberingSystem is a set of decimal digits, named with its CLDR identifier.
+// The zero value writes ASCII digits, like NumberingLatin.
+type NumberingSystem string
+
+const (
+	NumberingLatin      NumberingSystem = "latn"    // 0123456789
+	NumberingArabic     NumberingSystem = "arab"    // ٠١٢٣٤٥٦٧٨٩
+	NumberingPersian    NumberingSystem = "arabext" // ۰۱۲۳۴۵۶۷۸۹
+	NumberingDevanagari NumberingSystem = "deva"    // ०१२३४५६७८९
+	NumberingBengali    NumberingSystem = "beng"    // ০১২৩৪৫৬৭৮৯
+)
+
+// numberingZeros maps each NumberingSystem to its digit zero; the other
+// digits follow it in order.
+var numberingZeros = map[NumberingSystem]rune{
+	NumberingLatin:      '0',
+	NumberingArabic:     '٠',
+	NumberingPersian:    '۰',
+	NumberingDevanagari: '०',
+	NumberingBengali:    '০',
+}
+
+// zero returns the digit zero of the NumberingSystem, or '0' for an unknown one.
+func (ns NumberingSystem) zero() rune {
+	if zero, ok := numberingZeros[ns]; ok {
+		return zero
+	}
+	return '0'
+}
+
+// localize rewrites the ASCII digits of dst[start:] in the NumberingSystem.
+// It works in place, so it does not allocate when dst has enough capacity.
+func (ns NumberingSystem) localize(dst []byte, start int) []byte {
+	zero := ns.zero()
+	if zero == '0' {
+		return dst
+	}
+	digits := 0
+	for _, c := range dst[start:] {
+		if isDigit(c) {
+			digits++
+		}
+	}
+	if digits == 0 {
+		return dst
+	}
+
+	// Grow dst, then fill it from the end so no byte is overwritten before it is read.
+	size := utf8.RuneLen(zero)
+	end := len(dst)
+	for i := 0; i < digits*(size-1); i++ {
+		dst = append(dst, 0)
+	}
+	w := len(dst)
+	for r := end - 1; r >= start; r-- {
+		c := dst[r]
+		if !isDigit(c) {
+			w--
+			dst[w] = c
+			continue
+		}
+		w -= size
+		utf8.EncodeRune(dst[w:], zero+rune(c-'0'))
+	}
+	return dst
+}
+
+// LocalizeDigits rewrites the ASCII digits of s in the given NumberingSystem.
+//
+// Example:
+//
+//	LocalizeDigits("2024-03-21", NumberingArabic)    // "٢٠٢٤-٠٣-٢١"
+//	LocalizeDigits("2024", NumberingDevanagari)      // "२०२४"
+func LocalizeDigits(s string, ns NumberingSystem) string {
+	return string(ns.localize([]byte(s), 0))
+}
+
+// NormalizeDigits rewrites every Unicode decimal digit of s, such as the
+// Arabic-Indic "٢" or the Devanagari "२", as the ASCII digit of the same value.
+// Strings with only ASCII digits are returned unchanged.
+//
+// Example:
+//
+//	NormalizeDigits("٢٠٢٤-٠٣-٢١") // "2024-03-21"
+//	NormalizeDigits("۱۴۰۳/۰۱/۰۲") // "1403/01/02"
+func NormalizeDigits(s string) string {
+	i := 0
+	for i < len(s) && s[i] < utf8.RuneSelf {
+		i++
+	}
+	if i == len(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	b.WriteString(s[:i])
+	for _, r := range s[i:] {
+		if value, ok := digitValue(r); ok {
+			b.WriteByte(byte('0' + value))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// originalOffset maps a byte offset in NormalizeDigits(s) back to s, so
+// errors found while scanning the normalized string point into the input
+// the caller passed. Offsets inside a rune map to the start of the rune.
+func originalOffset(s string, offset int) int {
+	n := 0
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if _, ok := digitValue(r); ok {
+			n++
+		} else {
+			n += utf8.RuneLen(r)
+		}
+		if n > offset {
+			return i
+		}
+		i += size
+	}
+	return len(s)
+}
+
+// restoreInput rewrites a *ParseError or *AmbiguousDateError found while
+// parsing a rewritten copy of input, such as NormalizeDigits(input), to
+// report input itself. ParseError offsets are mapped back with
+// originalOffset. Other errors are returned unchanged.
+func restoreInput(err error, input string) error {
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) && parseErr.Input != input {
+		parseErr.Input = input
+		parseErr.Offset = originalOffset(input, parseErr.Offset)
+		for i := range parseErr.Attempts {
+			parseErr.Attempts[i].Offset = originalOffset(input, parseErr.Attempts[i].Offset)
+		}
+	}
+	var ambiguous *AmbiguousDateError
+	if errors.As(err, &ambiguous) {
+		ambiguous.Input = input
+	}
+	return err
+}
+
+// digitValue returns the value of a Unicode decimal digit. Every range of
+// unicode.Nd is a run of whole zero-to-nine blocks, so the value is the
+// distance from the start of the range modulo ten.
+func digitValue(r rune) (int, bool) {
+	if r >= '0' && r <= '9' {
+		return int(r - '0'), true
+	}
+	if r < utf8.RuneSelf || !unicode.Is(unicode.Nd, r) {
+		return 0, false
+	}
+	for _, rng := range unicode.Nd.R16 {
+		if r >= rune(rng.Lo) && r <= rune(rng.Hi) {
+			return int(r-rune(rng.Lo)) % 10, true
+		}
+	}
+	for _, rng := range unicode.Nd.R32 {
+		if r >= rune(rng.Lo) && r <= rune(rng.Hi) {
+			return int(r-rune(rng.Lo)) % 10, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,141 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLocalizeDigits(t *testing.T) {
+	tests := []struct {
+		input    string
+		ns       NumberingSystem
+		expected string
+	}{
+		{"2024-03-21", "", "2024-03-21"},
+		{"2024-03-21", NumberingLatin, "2024-03-21"},
+		{"2024-03-21", NumberingArabic, "٢٠٢٤-٠٣-٢١"},
+		{"1403/01/02", NumberingPersian, "۱۴۰۳/۰۱/۰۲"},
+		{"2024", NumberingDevanagari, "२०२४"},
+		{"21 March 2024", NumberingBengali, "২১ March ২০২৪"},
+		{"no digits", NumberingArabic, "no digits"},
+		{"2024", NumberingSystem("unknown"), "2024"},
+	}
+
+	for _, tt := range tests {
+		if got := LocalizeDigits(tt.input, tt.ns); got != tt.expected {
+			t.Errorf("LocalizeDigits(%q, %q) = %q, want %q", tt.input, tt.ns, got, tt.expected)
+		}
+	}
+}
+
+func TestNormalizeDigits(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"2024-03-21", "2024-03-21"},
+		{"٢٠٢٤-٠٣-٢١", "2024-03-21"},
+		{"۱۴۰۳/۰۱/۰۲", "1403/01/02"},
+		{"२०२४", "2024"},
+		{"২১ মার্চ ২০২৪", "21 মার্চ 2024"},
+		{"21 de março", "21 de março"},
+		{"𝟚𝟘𝟚𝟜", "2024"},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizeDigits(tt.input); got != tt.expected {
+			t.Errorf("NormalizeDigits(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+
+	// Every system round-trips through LocalizeDigits.
+	for ns := range numberingZeros {
+		if got := NormalizeDigits(LocalizeDigits("0123456789", ns)); got != "0123456789" {
+			t.Errorf("NormalizeDigits(LocalizeDigits(%q)) = %q", ns, got)
+		}
+	}
+}
+
+func TestNumberingSystemFormatting(t *testing.T) {
+	date := time.Date(2024, time.March, 21, 14, 5, 0, 0, time.UTC)
+	arabic := *LocaleEnUS
+	arabic.NumberingSystem = NumberingArabic
+
+	tests := []struct {
+		name     string
+		pattern  string
+		options  *FormatTokensOptions
+		expected string
+	}{
+		{"option", "yyyy-MM-dd HH:mm", &FormatTokensOptions{NumberingSystem: NumberingArabic}, "٢٠٢٤-٠٣-٢١ ١٤:٠٥"},
+		{"devanagari", "d/M/yyyy", &FormatTokensOptions{NumberingSystem: NumberingDevanagari}, "२१/३/२०२४"},
+		{"quoted literals keep ASCII digits", "'24/7' yyyy", &FormatTokensOptions{NumberingSystem: NumberingBengali}, "24/7 ২০২৪"},
+		{"ordinals and offsets", "do MMMM, XXX", &FormatTokensOptions{NumberingSystem: NumberingPersian}, "۲۱st March, Z"},
+		{"locale", "P", &FormatTokensOptions{Locale: &arabic}, "٠٣/٢١/٢٠٢٤"},
+		{"option overrides locale", "yyyy", &FormatTokensOptions{Locale: &arabic, NumberingSystem: NumberingLatin}, "2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatTokens(date, tt.pattern, tt.options)
+			if err != nil || got != tt.expected {
+				t.Errorf("FormatTokens(%q) = %q, %v, want %q", tt.pattern, got, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNumberingSystemParsing(t *testing.T) {
+	want := time.Date(2024, time.March, 21, 14, 5, 0, 0, time.UTC)
+
+	if got, err := ParseISO("٢٠٢٤-٠٣-٢١T١٤:٠٥:٠٠Z", nil); err != nil || !got.Equal(want) {
+		t.Errorf("ParseISO(arabic) = %v, %v, want %v", got, err, want)
+	}
+	if got, err := Parse("२०२४-०३-२१ १४:०५:००", nil); err != nil || !got.Equal(want) {
+		t.Errorf("Parse(devanagari) = %v, %v, want %v", got, err, want)
+	}
+	if got, err := ParseTokens("۲۱/۰۳/۲۰۲۴ ۱۴:۰۵", "dd/MM/yyyy HH:mm", want, nil); err != nil || !got.Equal(want) {
+		t.Errorf("ParseTokens(persian) = %v, %v, want %v", got, err, want)
+	}
+	if !IsValidISO("২০২৪-০৩-২১") {
+		t.Error("IsValidISO(bengali) = false, want true")
+	}
+}
+
+func TestNumberingSystemParseErrors(t *testing.T) {
+	parser := NewParser(nil)
+	tests := []struct {
+		name   string
+		input  string
+		parse  func(string) error
+		offset int
+	}{
+		{"ParseISO", "۲۰۲۴/۰۳/۲۱", func(s string) error { _, err := ParseISO(s, nil); return err }, 8},
+		{"ParseTokens", "۲۰۲۴/۰۳/۲۱", func(s string) error {
+			_, err := ParseTokens(s, "yyyy-MM-dd", time.Now(), nil)
+			return err
+		}, 8},
+		{"Strptime", "२०२४-०३-२१x", func(s string) error { _, err := Strptime(s, "%Y-%m-%d", nil); return err }, 26},
+		{"Parser", "२०२४-१३-२१", func(s string) error { _, err := parser.Parse(s); return err }, 19},
+		{"ParseIntervalText", "٢٠٢٤-٠١-٠١..", func(s string) error { _, err := ParseIntervalText(s, nil); return err }, 18},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var parseErr *ParseError
+			if err := tt.parse(tt.input); !errors.As(err, &parseErr) {
+				t.Fatalf("%s(%q) error = %v, want a *ParseError", tt.name, tt.input, err)
+			}
+			if parseErr.Input != tt.input || parseErr.Offset != tt.offset {
+				t.Errorf("%s(%q) error at %q offset %d, want offset %d", tt.name, tt.input, parseErr.Input, parseErr.Offset, tt.offset)
+			}
+		})
+	}
+}
+
+func BenchmarkNormalizeDigits(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = NormalizeDigits("٢٠٢٤-٠٣-٢١T١٤:٠٥:٠٠Z")
+	}
+}
@@ -1,11 +1,14 @@
 package dateutils
 
 import (
-	"errors"
+	"fmt"
 	"time"
 )
 
-// CommonDateFormats contains commonly used date formats
+// CommonDateFormats contains commonly used date formats.
+// It is the default layout list of NewParser and Parse. Parse snapshots it at
+// package initialization, so modifying it later has no effect; build a Parser
+// with its own Layouts instead.
 var CommonDateFormats = []string{
 	time.RFC3339,
 	time.RFC3339Nano,
@@ -27,10 +30,16 @@ var CommonDateFormats = []string{
 // Parse attempts to parse a date string using common date formats.
 // It tries multiple formats and returns the first successful parse.
 // If timezone is provided, the result will be converted to that timezone.
-// Returns an error if the string cannot be parsed with any known format.
+// Native digits, such as Arabic-Indic or Devanagari ones, are read as ASCII digits.
+// Returns a *ParseError listing every format tried if the string cannot be parsed,
+// or an error wrapping ErrEmptyInput for an empty string.
+//
+// Parse uses a default Parser; use NewParser for custom layouts, a default
+// location, a day/month preference or a two-digit-year pivot.
 func Parse(dateStr string, timezone *time.Location) (time.Time, error) {
-	if dateStr == "" {
-		return time.Time{}, errors.New("date string cannot be empty")
+	parsedTime, err := defaultParser.Parse(dateStr)
+	if err != nil {
+		return time.Time{}, err
 	}
 
 	// If no timezone is provided, use UTC
@@ -38,31 +47,19 @@ func Parse(dateStr string, timezone *time.Location) (time.Time, error) {
 		timezone = time.UTC
 	}
 
-	var lastErr error
-
-	// Try parsing with each common format
-	for _, format := range CommonDateFormats {
-		if parsedTime, err := time.Parse(format, dateStr); err == nil {
-			// Convert to the specified timezone
-			return parsedTime.In(timezone), nil
-		} else {
-			lastErr = err
-		}
-	}
-
-	// If no format worked, return the last error
-	return time.Time{}, errors.New("unable to parse date string: " + lastErr.Error())
+	// Convert to the specified timezone
+	return parsedTime.In(timezone), nil
 }
 
 // ParseWithFormat parses a date string using a specific format.
 // If timezone is provided, the result will be converted to that timezone.
-// Returns an error if the string cannot be parsed with the given format.
+// Returns a *ParseError if the string cannot be parsed with the given format.
 func ParseWithFormat(dateStr, format string, timezone *time.Location) (time.Time, error) {
 	if dateStr == "" {
-		return time.Time{}, errors.New("date string cannot be empty")
+		return time.Time{}, fmt.Errorf("cannot parse date string: %w", ErrEmptyInput)
 	}
 	if format == "" {
-		return time.Time{}, errors.New("format string cannot be empty")
+		return time.Time{}, fmt.Errorf("cannot parse date string: %w", ErrEmptyFormat)
 	}
 
 	// If no timezone is provided, use UTC
@@ -72,7 +69,7 @@ func ParseWithFormat(dateStr, format string, timezone *time.Location) (time.Time
 
 	parsedTime, err := time.Parse(format, dateStr)
 	if err != nil {
-		return time.Time{}, err
+		return time.Time{}, newParseError(dateStr, []LayoutAttempt{layoutAttempt(format, dateStr, err)})
 	}
 
 	// Convert to the specified timezone
@@ -2,20 +2,112 @@ package dateutils
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
+// Precision is the lowest-order component present in a parsed value.
+type Precision int
+
+const (
+	PrecisionYear      Precision = iota + 1 // 2024
+	PrecisionMonth                          // 2024-05
+	PrecisionWeek                           // 2024-W05
+	PrecisionDay                            // 2024-05-02, 2024-123, 2024-W05-3
+	PrecisionHour                           // T14, T14.5
+	PrecisionMinute                         // T14:30, T14:30.5
+	PrecisionSecond                         // T14:30:15
+	PrecisionSubsecond                      // T14:30:15.5
+)
+
+// String returns the lower-case name of the precision, such as "day".
+func (p Precision) String() string {
+	switch p {
+	case PrecisionYear:
+		return "year"
+	case PrecisionMonth:
+		return "month"
+	case PrecisionWeek:
+		return "week"
+	case PrecisionDay:
+		return "day"
+	case PrecisionHour:
+		return "hour"
+	case PrecisionMinute:
+		return "minute"
+	case PrecisionSecond:
+		return "second"
+	case PrecisionSubsecond:
+		return "subsecond"
+	}
+	return fmt.Sprintf("Precision(%d)", int(p))
+}
+
+// ISORepresentation is the ISO 8601 date representation of a parsed value.
+type ISORepresentation int
+
+const (
+	ISOCalendarDate ISORepresentation = iota + 1 // 2024-02-04, 20240204, 2024-02, 2024
+	ISOOrdinalDate                               // 2024-035, 2024035
+	ISOWeekDate                                  // 2024-W05-3, 2024W053, 2024-W05
+	ISOTimeOnly                                  // T14:30, T1430
+)
+
+// ISOResult is a value parsed by ParseISODetailed.
+type ISOResult struct {
+	Time           time.Time         // The parsed instant
+	Precision      Precision         // Lowest-order component present in the input
+	Representation ISORepresentation // Calendar, ordinal, week date or time only
+	Basic          bool              // Date (or time, for time-only inputs) in basic format
+	HasOffset      bool              // The input had Z or a UTC offset
+}
+
+// isoLayout is the layout name reported in the errors of the ISO 8601 parser.
+const isoLayout = "ISO 8601"
+
+// isoLayouts contains the Go layouts for the common ISO 8601 variants.
+// InferLayout adds them to its default candidates.
+var isoLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04:05Z",
+	"2006-01-02",
+}
+
 // ParseISO parses an ISO 8601 date string to time.Time.
-// Supports various ISO 8601 formats including:
-// - 2006-01-02T15:04:05Z
-// - 2006-01-02T15:04:05+07:00
-// - 2006-01-02T15:04:05.000Z
-// - 2006-01-02
+// It accepts the calendar, ordinal and week-date representations in basic
+// and extended format, reduced precision and decimal fractions of the
+// lowest-order time component, for example:
+// - 2006-01-02T15:04:05Z, 20060102T150405Z
+// - 2006-01-02T15:04:05,5+07:00, 2006-01-02T15:04:05.000+0700
+// - 2006-01-02, 2006-002, 2006-W01-1, 2006-01, 2006
+// - T15:04 (time only, on January 1 of year 0 like time.Parse)
+// Inputs without an offset are read as UTC. Native digits, such as
+// "٢٠٢٤-٠٣-٢١", are read as ASCII digits, and errors report the input as
+// given, with byte offsets into it.
 // If timezone is provided, the result will be converted to that timezone.
-// Returns an error if the string is not a valid ISO 8601 format.
+// Returns a *ParseError if the string is not a valid ISO 8601 format,
+// or an error wrapping ErrEmptyInput for an empty string.
 func ParseISO(isoStr string, timezone *time.Location) (time.Time, error) {
+	result, err := ParseISODetailed(isoStr, timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return result.Time, nil
+}
+
+// ParseISODetailed parses an ISO 8601 date string like ParseISO and also
+// reports the precision, representation and format the input used.
+//
+// Example:
+//
+//	result, _ := ParseISODetailed("2024-W05", nil)
+//	fmt.Println(result.Precision, result.Time.Format(time.DateOnly)) // week 2024-01-29
+func ParseISODetailed(isoStr string, timezone *time.Location) (ISOResult, error) {
 	if isoStr == "" {
-		return time.Time{}, errors.New("ISO string cannot be empty")
+		return ISOResult{}, fmt.Errorf("cannot parse ISO string: %w", ErrEmptyInput)
 	}
 
 	// If no timezone is provided, use UTC
@@ -23,30 +115,15 @@ func ParseISO(isoStr string, timezone *time.Location) (time.Time, error) {
 		timezone = time.UTC
 	}
 
-	// ISO 8601 formats to try
-	isoFormats := []string{
-		time.RFC3339Nano,
-		time.RFC3339,
-		"2006-01-02T15:04:05Z07:00",
-		"2006-01-02T15:04:05",
-		"2006-01-02T15:04:05Z",
-		"2006-01-02",
+	p := isoParser{input: NormalizeDigits(isoStr)}
+	result, err := p.parse()
+	if err != nil {
+		return ISOResult{}, newParseError(isoStr, []LayoutAttempt{{Layout: isoLayout, Offset: originalOffset(isoStr, p.pos), Err: err}})
 	}
 
-	var lastErr error
-
-	// Try parsing with each ISO format
-	for _, format := range isoFormats {
-		if parsedTime, err := time.Parse(format, isoStr); err == nil {
-			// Convert to the specified timezone
-			return parsedTime.In(timezone), nil
-		} else {
-			lastErr = err
-		}
-	}
-
-	// If no format worked, return the last error
-	return time.Time{}, errors.New("unable to parse ISO string: " + lastErr.Error())
+	// Convert to the specified timezone
+	result.Time = result.Time.In(timezone)
+	return result, nil
 }
 
 // IsValidISO checks if a string is a valid ISO 8601 date format.
@@ -55,3 +132,329 @@ func IsValidISO(isoStr string) bool {
 	_, err := ParseISO(isoStr, nil)
 	return err == nil
 }
+
+// isoParser is a cursor over an ISO 8601 string. On failure pos is the
+// offset of the element that could not be read.
+type isoParser struct {
+	input string
+	pos   int
+}
+
+func (p *isoParser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+// skip consumes c if it is the next byte.
+func (p *isoParser) skip(c byte) bool {
+	if p.peek() == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// run returns the number of consecutive digits at the cursor.
+func (p *isoParser) run() int {
+	n := 0
+	for p.pos+n < len(p.input) && isDigit(p.input[p.pos+n]) {
+		n++
+	}
+	return n
+}
+
+// number reads exactly n digits.
+func (p *isoParser) number(n int, what string) (int, error) {
+	if p.run() < n {
+		return 0, fmt.Errorf("expected %d-digit %s", n, what)
+	}
+	value := 0
+	for _, c := range p.input[p.pos : p.pos+n] {
+		value = value*10 + int(c-'0')
+	}
+	p.pos += n
+	return value, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (p *isoParser) parse() (ISOResult, error) {
+	var result ISOResult
+	date := time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	if c := p.peek(); c == 'T' || c == 't' {
+		p.pos++
+		result.Representation = ISOTimeOnly
+	} else {
+		var err error
+		if date, err = p.parseDate(&result); err != nil {
+			return result, err
+		}
+		if p.pos == len(p.input) {
+			result.Time = date
+			return result, nil
+		}
+		if c := p.peek(); c != 'T' && c != 't' && c != ' ' {
+			return result, errors.New("unexpected text after date")
+		}
+		if result.Precision != PrecisionDay {
+			return result, errors.New("time requires a complete date")
+		}
+		p.pos++
+	}
+
+	clock, loc, err := p.parseTime(&result)
+	if err != nil {
+		return result, err
+	}
+	if p.pos != len(p.input) {
+		return result, errors.New("unexpected text after time")
+	}
+
+	result.Time = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc).Add(clock)
+	return result, nil
+}
+
+// parseDate reads the date part, leaving the cursor after it.
+func (p *isoParser) parseDate(result *ISOResult) (time.Time, error) {
+	var year int
+	var err error
+	switch c := p.peek(); c {
+	case '+', '-':
+		// Expanded representation with two additional year digits.
+		p.pos++
+		if year, err = p.number(6, "expanded year"); err != nil {
+			return time.Time{}, err
+		}
+		if c == '-' {
+			year = -year
+		}
+	default:
+		if year, err = p.number(4, "year"); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	result.Representation = ISOCalendarDate
+	result.Precision = PrecisionYear
+	if p.pos == len(p.input) {
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), nil
+	}
+
+	extended := p.skip('-')
+	result.Basic = !extended
+
+	if p.skip('W') {
+		return p.parseWeekDate(result, year, extended)
+	}
+
+	switch n := p.run(); {
+	case n == 3:
+		start := p.pos
+		dayOfYear, _ := p.number(3, "day of year")
+		if dayOfYear < 1 || dayOfYear > daysInYear(year) {
+			p.pos = start
+			return time.Time{}, fmt.Errorf("day of year %d out of range", dayOfYear)
+		}
+		result.Representation = ISOOrdinalDate
+		result.Precision = PrecisionDay
+		return time.Date(year, time.January, dayOfYear, 0, 0, 0, 0, time.UTC), nil
+
+	case extended && n == 2, !extended && n >= 4:
+		start := p.pos
+		month, _ := p.number(2, "month")
+		if month < 1 || month > 12 {
+			p.pos = start
+			return time.Time{}, fmt.Errorf("month %d out of range", month)
+		}
+		result.Precision = PrecisionMonth
+		if extended && !p.skip('-') {
+			// Reduced precision is only valid in extended format (YYYY-MM).
+			return time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC), nil
+		}
+
+		start = p.pos
+		day, err := p.number(2, "day")
+		if err != nil {
+			return time.Time{}, err
+		}
+		if day < 1 || day > daysIn(year, month) {
+			p.pos = start
+			return time.Time{}, fmt.Errorf("day %d out of range for %s", day, time.Month(month))
+		}
+		result.Precision = PrecisionDay
+		return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), nil
+	}
+
+	return time.Time{}, errors.New("expected month, day of year or week")
+}
+
+// parseWeekDate reads a week date after the W designator.
+func (p *isoParser) parseWeekDate(result *ISOResult, year int, extended bool) (time.Time, error) {
+	start := p.pos
+	week, err := p.number(2, "week")
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	firstMonday := isoWeekYearStart(year)
+	weeks := (isoWeekYearStart(year+1) - firstMonday) / 7
+	if week < 1 || week > weeks {
+		p.pos = start
+		return time.Time{}, fmt.Errorf("week %d out of range", week)
+	}
+
+	result.Representation = ISOWeekDate
+	result.Precision = PrecisionWeek
+	weekday := 1
+	if (extended && p.skip('-')) || (!extended && isDigit(p.peek())) {
+		start = p.pos
+		if weekday, err = p.number(1, "day of week"); err != nil {
+			return time.Time{}, err
+		}
+		if weekday < 1 || weekday > 7 {
+			p.pos = start
+			return time.Time{}, fmt.Errorf("day of week %d out of range", weekday)
+		}
+		result.Precision = PrecisionDay
+	}
+
+	day := firstMonday + (week-1)*7 + weekday - 1
+	return time.Unix(int64(day)*86400, 0).UTC(), nil
+}
+
+// parseTime reads the time of day and offset, returning the time since
+// midnight and the location of the offset.
+func (p *isoParser) parseTime(result *ISOResult) (time.Duration, *time.Location, error) {
+	hourAt, minuteAt, secondAt := p.pos, 0, 0
+	hour, err := p.number(2, "hour")
+	if err != nil {
+		return 0, nil, err
+	}
+	minute, second := 0, 0
+	unit := time.Hour
+	result.Precision = PrecisionHour
+
+	extended := p.peek() == ':'
+	if result.Representation == ISOTimeOnly {
+		result.Basic = !extended && isDigit(p.peek())
+	}
+	if (extended && p.pos+1 < len(p.input) && isDigit(p.input[p.pos+1])) || (!extended && isDigit(p.peek())) {
+		p.skip(':')
+		minuteAt = p.pos
+		if minute, err = p.number(2, "minute"); err != nil {
+			return 0, nil, err
+		}
+		unit = time.Minute
+		result.Precision = PrecisionMinute
+
+		if (extended && p.skip(':')) || (!extended && isDigit(p.peek())) {
+			secondAt = p.pos
+			if second, err = p.number(2, "second"); err != nil {
+				return 0, nil, err
+			}
+			unit = time.Second
+			result.Precision = PrecisionSecond
+		}
+	}
+
+	// A decimal fraction applies to the lowest-order component present.
+	var fraction time.Duration
+	if c := p.peek(); (c == '.' || c == ',') && p.pos+1 < len(p.input) && isDigit(p.input[p.pos+1]) {
+		p.pos++
+		nanos := 0
+		for i := 0; i < 9; i++ {
+			nanos *= 10
+			if isDigit(p.peek()) {
+				nanos += int(p.input[p.pos] - '0')
+				p.pos++
+			}
+		}
+		for isDigit(p.peek()) {
+			p.pos++
+		}
+		fraction = time.Duration(nanos) * (unit / time.Second)
+		if unit == time.Second {
+			result.Precision = PrecisionSubsecond
+		}
+	}
+
+	switch {
+	case hour > 24:
+		p.pos = hourAt
+		return 0, nil, fmt.Errorf("hour %d out of range", hour)
+	case minute > 59:
+		p.pos = minuteAt
+		return 0, nil, fmt.Errorf("minute %d out of range", minute)
+	case second > 59:
+		p.pos = secondAt
+		return 0, nil, fmt.Errorf("second %d out of range", second)
+	case hour == 24 && (minute != 0 || second != 0 || fraction != 0):
+		p.pos = hourAt
+		return 0, nil, errors.New("hour 24 is only valid as 24:00:00")
+	}
+
+	loc, err := p.parseOffset(result)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	clock := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute +
+		time.Duration(second)*time.Second + fraction
+	return clock, loc, nil
+}
+
+// parseOffset reads an optional Z or ±hh[[:]mm] offset.
+func (p *isoParser) parseOffset(result *ISOResult) (*time.Location, error) {
+	c := p.peek()
+	switch c {
+	case 'Z', 'z':
+		p.pos++
+		result.HasOffset = true
+		return time.UTC, nil
+	case '+', '-':
+	default:
+		return time.UTC, nil
+	}
+
+	start := p.pos
+	p.pos++
+	hours, err := p.number(2, "offset hour")
+	if err != nil {
+		return nil, err
+	}
+	minutes := 0
+	if p.skip(':') || isDigit(p.peek()) {
+		if minutes, err = p.number(2, "offset minute"); err != nil {
+			return nil, err
+		}
+	}
+	if hours > 23 || minutes > 59 {
+		text := p.input[start:p.pos]
+		p.pos = start
+		return nil, fmt.Errorf("offset %s out of range", text)
+	}
+
+	offset := hours*3600 + minutes*60
+	if c == '-' {
+		offset = -offset
+	}
+	result.HasOffset = true
+	return time.FixedZone("", offset), nil
+}
+
+// isoWeekYearStart returns the civil day of the Monday that starts week 1 of
+// the ISO week-numbering year, the week containing January 4th.
+func isoWeekYearStart(year int) int {
+	anchor := civilDay(year, time.January, 4)
+	return anchor - weekdayOffset(anchor, time.Monday)
+}
+
+// daysInYear returns 366 for leap years and 365 otherwise.
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
@@ -1,6 +1,7 @@
 package dateutils
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -95,6 +96,113 @@ func TestParseISO(t *testing.T) {
 	}
 }
 
+func TestParseISODetailed(t *testing.T) {
+	ist := time.FixedZone("", 5*3600+30*60)
+
+	tests := []struct {
+		name               string
+		isoStr             string
+		want               time.Time
+		wantPrecision      Precision
+		wantRepresentation ISORepresentation
+		wantBasic          bool
+		wantOffset         bool
+	}{
+		{name: "Week date", isoStr: "2024-W05-3",
+			want: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), wantPrecision: PrecisionDay, wantRepresentation: ISOWeekDate},
+		{name: "Week date basic", isoStr: "2024W053",
+			want: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), wantPrecision: PrecisionDay, wantRepresentation: ISOWeekDate, wantBasic: true},
+		{name: "Week only", isoStr: "2024-W05",
+			want: time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC), wantPrecision: PrecisionWeek, wantRepresentation: ISOWeekDate},
+		{name: "Week 53 in previous year", isoStr: "2020-W53-7",
+			want: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), wantPrecision: PrecisionDay, wantRepresentation: ISOWeekDate},
+		{name: "Week 1 starting in December", isoStr: "2025-W01-1",
+			want: time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), wantPrecision: PrecisionDay, wantRepresentation: ISOWeekDate},
+		{name: "Ordinal date", isoStr: "2024-035",
+			want: time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC), wantPrecision: PrecisionDay, wantRepresentation: ISOOrdinalDate},
+		{name: "Ordinal date basic", isoStr: "2024366",
+			want: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), wantPrecision: PrecisionDay, wantRepresentation: ISOOrdinalDate, wantBasic: true},
+		{name: "Basic date and time", isoStr: "20240102T030405Z",
+			want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), wantPrecision: PrecisionSecond, wantRepresentation: ISOCalendarDate,
+			wantBasic: true, wantOffset: true},
+		{name: "Year and month", isoStr: "2024-05",
+			want: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), wantPrecision: PrecisionMonth, wantRepresentation: ISOCalendarDate},
+		{name: "Year only", isoStr: "2024",
+			want: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), wantPrecision: PrecisionYear, wantRepresentation: ISOCalendarDate},
+		{name: "Expanded year", isoStr: "-000043-03-15",
+			want: time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), wantPrecision: PrecisionDay, wantRepresentation: ISOCalendarDate},
+		{name: "Time only", isoStr: "T14:30",
+			want: time.Date(0, 1, 1, 14, 30, 0, 0, time.UTC), wantPrecision: PrecisionMinute, wantRepresentation: ISOTimeOnly},
+		{name: "Time only basic", isoStr: "T143015",
+			want: time.Date(0, 1, 1, 14, 30, 15, 0, time.UTC), wantPrecision: PrecisionSecond, wantRepresentation: ISOTimeOnly, wantBasic: true},
+		{name: "Comma decimal and basic offset", isoStr: "2024-01-02T10:30:15,5+0530",
+			want: time.Date(2024, 1, 2, 10, 30, 15, 500000000, ist), wantPrecision: PrecisionSubsecond,
+			wantRepresentation: ISOCalendarDate, wantOffset: true},
+		{name: "Fractional hour", isoStr: "2024-01-02T10.25",
+			want: time.Date(2024, 1, 2, 10, 15, 0, 0, time.UTC), wantPrecision: PrecisionHour, wantRepresentation: ISOCalendarDate},
+		{name: "Fractional minute", isoStr: "2024-01-02T10:30.5-03",
+			want: time.Date(2024, 1, 2, 13, 30, 30, 0, time.UTC), wantPrecision: PrecisionMinute,
+			wantRepresentation: ISOCalendarDate, wantOffset: true},
+		{name: "End of day", isoStr: "2024-12-31T24:00:00",
+			want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), wantPrecision: PrecisionSecond, wantRepresentation: ISOCalendarDate},
+		{name: "Space separator", isoStr: "2024-01-02 10:30:15Z",
+			want: time.Date(2024, 1, 2, 10, 30, 15, 0, time.UTC), wantPrecision: PrecisionSecond,
+			wantRepresentation: ISOCalendarDate, wantOffset: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseISODetailed(tt.isoStr, nil)
+			if err != nil {
+				t.Fatalf("ParseISODetailed() unexpected error = %v", err)
+			}
+			if !got.Time.Equal(tt.want) {
+				t.Errorf("Time = %v, want %v", got.Time, tt.want)
+			}
+			if got.Precision != tt.wantPrecision || got.Representation != tt.wantRepresentation {
+				t.Errorf("Precision, Representation = %v, %v, want %v, %v", got.Precision, got.Representation, tt.wantPrecision, tt.wantRepresentation)
+			}
+			if got.Basic != tt.wantBasic || got.HasOffset != tt.wantOffset {
+				t.Errorf("Basic, HasOffset = %v, %v, want %v, %v", got.Basic, got.HasOffset, tt.wantBasic, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestParseISOInvalid(t *testing.T) {
+	tests := []struct {
+		isoStr     string
+		wantOffset int
+	}{
+		{"2024-13-01", 5},
+		{"2023-02-29", 8},
+		{"2023-366", 5},
+		{"2021-W53", 6},
+		{"2024-W05-8", 9},
+		{"202405", 4},
+		{"2024-05T10:00", 7},
+		{"2024-01-02T25:00", 11},
+		{"2024-01-02T24:30", 11},
+		{"2024-01-02T10:60", 14},
+		{"2024-01-02T10:00+24:00", 16},
+		{"2024-01-02T10:00:00 UTC", 19},
+		{"24-01-02", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.isoStr, func(t *testing.T) {
+			_, err := ParseISO(tt.isoStr, nil)
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ParseISO() error = %v, want *ParseError", err)
+			}
+			if parseErr.Offset != tt.wantOffset {
+				t.Errorf("Offset = %d, want %d (%v)", parseErr.Offset, tt.wantOffset, err)
+			}
+		})
+	}
+}
+
 func TestIsValidISO(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -136,6 +244,21 @@ func TestIsValidISO(t *testing.T) {
 			isoStr: "not-a-date",
 			want:   false,
 		},
+		{
+			name:   "Valid week date",
+			isoStr: "2024-W05-3",
+			want:   true,
+		},
+		{
+			name:   "Valid basic format",
+			isoStr: "20240102T030405Z",
+			want:   true,
+		},
+		{
+			name:   "Valid reduced precision",
+			isoStr: "2024-05",
+			want:   true,
+		},
 		{
 			name:   "US format (not ISO)",
 			isoStr: "12/25/2023",
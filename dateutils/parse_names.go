@@ -0,0 +1,235 @@
+package dateutils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// nameNormalizer rewrites dates written with localized month and weekday
+// names into the English forms the Go layouts understand: month names become
+// English wide or abbreviated names, while weekday names, connector words and
+// ordinal suffixes are dropped, so "segunda-feira, 1º de janeiro de 2024" becomes
+// "1 January 2024". Names are matched case- and accent-insensitively, and
+// abbreviations with or without their trailing dot.
+type nameNormalizer struct {
+	months     map[string]monthName // Keyed by folded name
+	weekdays   map[string]bool
+	connectors map[string]bool
+}
+
+// monthName is a month number and whether the name is an abbreviation.
+type monthName struct {
+	month       int
+	abbreviated bool
+}
+
+// ordinalSuffixes are the suffixes dropped after a day number, folded.
+var ordinalSuffixes = map[string]bool{
+	"st": true, "nd": true, "rd": true, "th": true, // 1st, 2nd, 3rd, 4th
+	"er": true, "re": true, "e": true, // 1er, 1re, 2e
+	"o": true, "a": true, // 1º, 1ª
+}
+
+// newNameNormalizer indexes the names of the locales. When two locales use
+// the same name for different months, the earlier locale wins.
+func newNameNormalizer(locales []*Locale) *nameNormalizer {
+	n := &nameNormalizer{months: map[string]monthName{}, weekdays: map[string]bool{}, connectors: map[string]bool{}}
+	for _, locale := range locales {
+		if locale == nil {
+			continue
+		}
+		for i := 0; i < 12; i++ {
+			n.addMonth(locale.MonthsWide[i], monthName{month: i + 1})
+			n.addMonth(locale.MonthsWideStandalone[i], monthName{month: i + 1})
+			n.addMonth(locale.MonthsAbbreviated[i], monthName{month: i + 1, abbreviated: true})
+		}
+		for i := 0; i < 7; i++ {
+			for _, name := range []string{locale.WeekdaysWide[i], locale.WeekdaysAbbreviated[i]} {
+				if key := foldName(name); key != "" {
+					n.weekdays[key] = true
+				}
+			}
+		}
+		for _, word := range locale.Connectors {
+			n.connectors[foldName(word)] = true
+		}
+	}
+	return n
+}
+
+// addMonth indexes a month name unless an earlier one took it.
+func (n *nameNormalizer) addMonth(name string, month monthName) {
+	if key := foldName(name); key != "" {
+		if _, ok := n.months[key]; !ok {
+			n.months[key] = month
+		}
+	}
+}
+
+// nameItem is a word, a number or a run of other characters of the input.
+type nameItem struct {
+	text  string
+	kind  byte      // 'w' word, 'n' number, 'o' other
+	month monthName // Set when the word is a month name
+	drop  bool
+}
+
+// normalize returns the rewritten input and whether anything was rewritten.
+func (n *nameNormalizer) normalize(input string) (string, bool) {
+	items := splitNameItems(input)
+
+	// A word that is both a month and a weekday abbreviation, such as Spanish
+	// "mar", is a weekday when it comes before the day number and another
+	// word names the month, as in "mar. 5 mar. 2024"; otherwise it is a month.
+	weekdayAt := map[int]bool{}
+	dayNumber := false
+	monthWords := 0
+	for i, item := range items {
+		if item.kind == 'n' && len(item.text) <= 2 {
+			dayNumber = true
+		}
+		if item.kind != 'w' {
+			continue
+		}
+		key := foldName(item.text)
+		if _, ok := n.months[key]; !ok {
+			continue
+		}
+		if n.weekdays[key] && !dayNumber {
+			weekdayAt[i] = true
+		} else {
+			monthWords++
+		}
+	}
+	if monthWords == 0 || !dayNumber {
+		clear(weekdayAt)
+	}
+
+	changed := false
+	for i := range items {
+		item := &items[i]
+		if item.kind != 'w' {
+			continue
+		}
+		key := foldName(item.text)
+		month, isMonth := n.months[key]
+		switch {
+		case isMonth && !weekdayAt[i]:
+			item.month = month
+			changed = true
+		case n.weekdays[key] || n.connectors[key]:
+			item.drop = true
+			changed = true
+		case i > 0 && items[i-1].kind == 'n' && len(items[i-1].text) <= 2 && ordinalSuffixes[strings.TrimSuffix(key, ".")]:
+			item.drop = true
+			changed = true
+		}
+	}
+	if !changed {
+		return input, false
+	}
+
+	var b strings.Builder
+	for i, item := range items {
+		switch {
+		case item.drop:
+		case item.month.abbreviated:
+			b.WriteString(LocaleEnUS.MonthsAbbreviated[item.month.month-1])
+		case item.month.month > 0:
+			b.WriteString(LocaleEnUS.MonthsWide[item.month.month-1])
+		case item.kind == 'o' && strings.HasPrefix(item.text, ".") && i > 0 && items[i-1].kind == 'n' &&
+			len(items[i-1].text) <= 2 && nextMonth(items[i+1:]):
+			// German and Polish day numbers: "3. März"
+			b.WriteString(item.text[1:])
+		default:
+			b.WriteString(item.text)
+		}
+	}
+	return cleanNormalized(b.String()), true
+}
+
+// nextMonth reports whether the next word of items is a month name.
+func nextMonth(items []nameItem) bool {
+	for _, item := range items {
+		if item.kind == 'w' {
+			return item.month.month > 0
+		}
+		if item.kind == 'n' {
+			return false
+		}
+	}
+	return false
+}
+
+// splitNameItems splits input into words, numbers and other runs. Words keep
+// a trailing dot and may contain hyphens, as in "segunda-feira".
+func splitNameItems(input string) []nameItem {
+	var items []nameItem
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		start := i
+		switch {
+		case unicode.IsLetter(runes[i]):
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.Is(unicode.Mn, runes[i]) ||
+				runes[i] == '-' && i+1 < len(runes) && unicode.IsLetter(runes[i+1])) {
+				i++
+			}
+			if i < len(runes) && runes[i] == '.' {
+				i++
+			}
+			items = append(items, nameItem{text: string(runes[start:i]), kind: 'w'})
+		case unicode.IsDigit(runes[i]):
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+			items = append(items, nameItem{text: string(runes[start:i]), kind: 'n'})
+		default:
+			for i < len(runes) && !unicode.IsLetter(runes[i]) && !unicode.IsDigit(runes[i]) {
+				i++
+			}
+			items = append(items, nameItem{text: string(runes[start:i]), kind: 'o'})
+		}
+	}
+	return items
+}
+
+// cleanNormalized collapses the spaces and commas left by dropped words.
+func cleanNormalized(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	s = strings.ReplaceAll(s, " ,", ",")
+	for strings.Contains(s, ",,") {
+		s = strings.ReplaceAll(s, ",,", ",")
+	}
+	return strings.Trim(s, " ,")
+}
+
+// foldName lowercases a name and removes its accents and trailing dot,
+// so "Févr." and "fevr" compare equal.
+func foldName(name string) string {
+	name = strings.TrimSuffix(name, ".")
+	return strings.Map(func(r rune) rune {
+		r = unicode.ToLower(r)
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		if folded, ok := accentFolds[r]; ok {
+			return folded
+		}
+		return r
+	}, name)
+}
+
+// accentFolds maps accented letters of the built-in locales to their base letter.
+var accentFolds = map[rune]rune{
+	'á': 'a', 'à': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a', 'å': 'a', 'ą': 'a',
+	'ç': 'c', 'ć': 'c',
+	'é': 'e', 'è': 'e', 'ê': 'e', 'ë': 'e', 'ę': 'e',
+	'í': 'i', 'ì': 'i', 'î': 'i', 'ï': 'i',
+	'ł': 'l', 'ñ': 'n', 'ń': 'n',
+	'ó': 'o', 'ò': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o', 'º': 'o',
+	'ś': 's',
+	'ú': 'u', 'ù': 'u', 'û': 'u', 'ü': 'u',
+	'ý': 'y', 'ÿ': 'y',
+	'ź': 'z', 'ż': 'z',
+	'ª': 'a', 'ё': 'е', 'й': 'и',
+}
@@ -0,0 +1,53 @@
+package dateutils
+
+import "testing"
+
+func TestFoldName(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "Févr.", want: "fevr"},
+		{input: "MÄRZ", want: "marz"},
+		{input: "março", want: "marco"},
+		{input: "Październik", want: "pazdziernik"},
+		{input: "ÑOÑO", want: "nono"},
+		{input: "Mär", want: "mar"},
+		{input: "Ёлка", want: "елка"},
+	}
+
+	for _, tt := range tests {
+		if got := foldName(tt.input); got != tt.want {
+			t.Errorf("foldName(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNameNormalizerNormalize(t *testing.T) {
+	normalizer := newNameNormalizer([]*Locale{LocalePtBR, LocaleDE, LocaleFR, LocaleES})
+
+	tests := []struct {
+		input   string
+		want    string
+		changed bool
+	}{
+		{input: "2 de janeiro de 2024", want: "2 January 2024", changed: true},
+		{input: "Donnerstag, 21. März 2024", want: "21 March 2024", changed: true},
+		{input: "lun. 5 févr. 2024", want: "5 Feb 2024", changed: true},
+		{input: "1er mars 2024", want: "1 March 2024", changed: true},
+		{input: "martes, 5 de marzo de 2024 14:05", want: "5 March 2024 14:05", changed: true},
+		{input: "5 mar 2024", want: "5 Mar 2024", changed: true},
+		{input: "mar. 5 mar. 2024", want: "5 Mar 2024", changed: true},
+		{input: "mar, 5 de marzo de 2024", want: "5 March 2024", changed: true},
+		{input: "mar 5, 2024", want: "Mar 5, 2024", changed: true},
+		{input: "2024-03-21", want: "2024-03-21", changed: false},
+		{input: "quarta 3", want: "quarta 3", changed: false},
+	}
+
+	for _, tt := range tests {
+		got, changed := normalizer.normalize(tt.input)
+		if got != tt.want || changed != tt.changed {
+			t.Errorf("normalize(%q) = %q, %v; want %q, %v", tt.input, got, changed, tt.want, tt.changed)
+		}
+	}
+}
@@ -0,0 +1,738 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTokensOptions represents options for ParseTokens and IsMatch.
+type ParseTokensOptions struct {
+	// WeekStartsOn is the first day of the week used by the local week tokens
+	// (Y, w, e, c). The zero value is Sunday, matching date-fns en-US.
+	WeekStartsOn time.Weekday
+
+	// FirstWeekContainsDate is the day of January that is always in the first
+	// local week of the year (1-7). Zero means 1, matching date-fns en-US.
+	FirstWeekContainsDate int
+
+	// UseAdditionalWeekYearTokens allows YY and YYYY, which are usually typos for yy and yyyy.
+	UseAdditionalWeekYearTokens bool
+
+	// UseAdditionalDayOfYearTokens allows D and DD, which are usually typos for d and dd.
+	UseAdditionalDayOfYearTokens bool
+}
+
+// tokenDate holds the calendar fields being assembled by ParseTokens.
+type tokenDate struct {
+	year, month, day            int
+	hour, minute, second, nanos int
+}
+
+// tokenFlags carries parse state that is not a calendar field.
+type tokenFlags struct {
+	era          int // -1 when no era token was parsed
+	offset       int
+	hasOffset    bool
+	timestampSet bool
+}
+
+// tokenSetter applies one parsed value to the date being assembled.
+// Setters run from the highest to the lowest priority, so that coarser fields
+// are settled before finer ones, exactly as in date-fns parse.
+type tokenSetter struct {
+	priority    int
+	subPriority int // Breaks ties: day-of-month and day-of-year run before weekdays
+	offset      int // Byte offset of the parsed value in the input
+	apply       func(d *tokenDate, f *tokenFlags) bool
+}
+
+// tokenInput is a cursor over the string being parsed.
+type tokenInput struct {
+	s   string
+	pos int
+}
+
+func (in *tokenInput) rest() string {
+	return in.s[in.pos:]
+}
+
+// digits consumes between minDigits and maxDigits ASCII digits.
+func (in *tokenInput) digits(minDigits, maxDigits int) (int, bool) {
+	n := 0
+	value := 0
+	for in.pos+n < len(in.s) && n < maxDigits {
+		c := in.s[in.pos+n]
+		if c < '0' || c > '9' {
+			break
+		}
+		value = value*10 + int(c-'0')
+		n++
+	}
+	if n < minDigits || n == 0 {
+		return 0, false
+	}
+	in.pos += n
+	return value, true
+}
+
+// signedDigits consumes an optionally negative run of digits.
+func (in *tokenInput) signedDigits() (int64, bool) {
+	start := in.pos
+	if in.pos < len(in.s) && in.s[in.pos] == '-' {
+		in.pos++
+	}
+	digitStart := in.pos
+	for in.pos < len(in.s) && in.s[in.pos] >= '0' && in.s[in.pos] <= '9' {
+		in.pos++
+	}
+	if in.pos == digitStart {
+		in.pos = start
+		return 0, false
+	}
+	value, err := strconv.ParseInt(in.s[start:in.pos], 10, 64)
+	if err != nil {
+		in.pos = start
+		return 0, false
+	}
+	return value, true
+}
+
+// ordinal consumes a number followed by an optional ordinal suffix.
+func (in *tokenInput) ordinal() (int, bool) {
+	value, ok := in.digits(1, 9)
+	if !ok {
+		return 0, false
+	}
+	rest := in.rest()
+	for _, suffix := range [...]string{"st", "nd", "rd", "th"} {
+		if len(rest) >= 2 && strings.EqualFold(rest[:2], suffix) {
+			in.pos += 2
+			break
+		}
+	}
+	return value, true
+}
+
+// name consumes the longest case-insensitive match from the given name lists,
+// trying the lists in order. It returns the index of the match within its list.
+func (in *tokenInput) name(lists ...[]string) (int, bool) {
+	rest := in.rest()
+	for _, names := range lists {
+		best, bestLen := -1, 0
+		for i, name := range names {
+			if len(name) > bestLen && len(rest) >= len(name) && strings.EqualFold(rest[:len(name)], name) {
+				best, bestLen = i, len(name)
+			}
+		}
+		if best >= 0 {
+			in.pos += bestLen
+			return best, true
+		}
+	}
+	return 0, false
+}
+
+// ParseTokens parses a string using the same date-fns token grammar as FormatTokens.
+// Fields that are not present in the pattern are taken from the reference date,
+// while fields finer than the finest parsed field are reset to zero: parsing
+// "2024" with "yyyy" yields January 1, 2024 00:00, and parsing "14:30" with
+// "HH:mm" yields 14:30 on the reference day. This mirrors date-fns parse.
+//
+// Ordinals (do), month and weekday names (MMM, MMMM, EEEE), quarters (Q, QQQ),
+// ISO week dates (RRRR, II, i), 12-hour clocks (h, a), offsets (X, x) and Unix
+// timestamps (t, T) are supported. The result is in the reference date's location;
+// when the input carries an offset the instant is converted to that location.
+// Native digits, such as Arabic-Indic or Devanagari ones, are read as ASCII digits.
+//
+// Example:
+//
+//	ref := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+//	ParseTokens("21/03/2024 14:05", "dd/MM/yyyy HH:mm", ref, nil)
+//	// Returns: 2024-03-21 14:05:00 +0000 UTC
+func ParseTokens(input, pattern string, reference time.Time, options *ParseTokensOptions) (time.Time, error) {
+	if input == "" {
+		return time.Time{}, fmt.Errorf("cannot parse date string: %w", ErrEmptyInput)
+	}
+	if pattern == "" {
+		return time.Time{}, fmt.Errorf("cannot parse date string: %w", ErrEmptyFormat)
+	}
+	if options == nil {
+		options = &ParseTokensOptions{}
+	}
+
+	tokens, err := tokenizePattern(pattern, options.UseAdditionalWeekYearTokens, options.UseAdditionalDayOfYearTokens)
+	if err != nil {
+		return time.Time{}, err
+	}
+	tokens = expandLongTokens(tokens)
+	for _, tok := range tokens {
+		// Spelled-out numbers are only written, never read.
+		if tok.modifier == 'n' || tok.modifier == 'N' {
+			suggestion := string(tok.letter)
+			if tok.modifier == 'N' {
+				suggestion += "o"
+			}
+			return time.Time{}, &TokenError{Pattern: pattern, Token: tok.text(), Offset: tok.offset,
+				Suggestion: suggestion, Err: ErrUnknownToken}
+		}
+	}
+
+	in := &tokenInput{s: NormalizeDigits(input)}
+	var setters []tokenSetter
+	for _, tok := range tokens {
+		if tok.letter == 0 {
+			if !strings.HasPrefix(in.rest(), tok.literal) {
+				return time.Time{}, tokenParseError(input, pattern, in.pos, fmt.Sprintf("expected %q", tok.literal))
+			}
+			in.pos += len(tok.literal)
+			continue
+		}
+
+		start := in.pos
+		setter, ok := parseToken(in, tok, reference, options)
+		if !ok {
+			in.pos = start
+			return time.Time{}, tokenParseError(input, pattern, start,
+				"cannot parse token "+strconv.Quote(tok.text()))
+		}
+		setter.offset = start
+		setters = append(setters, setter)
+	}
+
+	if strings.TrimSpace(in.rest()) != "" {
+		return time.Time{}, tokenParseError(input, pattern, in.pos, "unexpected trailing text")
+	}
+
+	sort.SliceStable(setters, func(i, j int) bool {
+		if setters[i].priority != setters[j].priority {
+			return setters[i].priority > setters[j].priority
+		}
+		return setters[i].subPriority > setters[j].subPriority
+	})
+
+	d := tokenDate{
+		year: reference.Year(), month: int(reference.Month()), day: reference.Day(),
+		hour: reference.Hour(), minute: reference.Minute(), second: reference.Second(), nanos: reference.Nanosecond(),
+	}
+	flags := tokenFlags{era: -1}
+	for _, setter := range setters {
+		if !setter.apply(&d, &flags) {
+			return time.Time{}, tokenParseError(input, pattern, setter.offset, "value out of range")
+		}
+	}
+
+	loc := reference.Location()
+	if flags.hasOffset && !flags.timestampSet {
+		zone := time.FixedZone("", flags.offset)
+		return time.Date(d.year, time.Month(d.month), d.day, d.hour, d.minute, d.second, d.nanos, zone).In(loc), nil
+	}
+	return time.Date(d.year, time.Month(d.month), d.day, d.hour, d.minute, d.second, d.nanos, loc), nil
+}
+
+// IsMatch reports whether input can be parsed with the given date-fns token pattern.
+// It is the validation counterpart of ParseTokens and is equivalent to date-fns isMatch.
+//
+// Example:
+//
+//	IsMatch("21/03/2024", "dd/MM/yyyy", nil) // true
+//	IsMatch("31/02/2024", "dd/MM/yyyy", nil) // false
+func IsMatch(input, pattern string, options *ParseTokensOptions) bool {
+	_, err := ParseTokens(input, pattern, time.Now(), options)
+	return err == nil
+}
+
+// tokenParseError reports a ParseTokens failure as a *ParseError with a single attempt.
+// The offset is in NormalizeDigits(input) and is reported in input.
+func tokenParseError(input, pattern string, offset int, reason string) error {
+	offset = originalOffset(input, offset)
+	attempt := LayoutAttempt{Layout: pattern, Offset: offset, Err: errors.New(reason + " at offset " + strconv.Itoa(offset))}
+	return newParseError(input, []LayoutAttempt{attempt})
+}
+
+// expandLongTokens replaces localized P/p tokens with the tokens of their patterns.
+func expandLongTokens(tokens []patternToken) []patternToken {
+	expanded := tokens[:0:0]
+	for _, tok := range tokens {
+		if tok.letter != 'P' && tok.letter != 'p' {
+			expanded = append(expanded, tok)
+			continue
+		}
+		sub, err := tokenizePattern(longFormatPattern(tok, LocaleEnUS), true, true)
+		if err != nil {
+			continue
+		}
+		expanded = append(expanded, sub...)
+	}
+	return expanded
+}
+
+// resetTime clears the time of day, as date-fns does after setting a date field.
+func (d *tokenDate) resetTime() {
+	d.hour, d.minute, d.second, d.nanos = 0, 0, 0, 0
+}
+
+// setCivilDay moves the date to the given day count since the Unix epoch.
+func (d *tokenDate) setCivilDay(day int) {
+	t := time.Unix(int64(day)*86400, 0).UTC()
+	d.year, d.month, d.day = t.Year(), int(t.Month()), t.Day()
+}
+
+func (d *tokenDate) civilDay() int {
+	return civilDay(d.year, time.Month(d.month), d.day)
+}
+
+// normalizeTwoDigitYear maps a two-digit year to the century that puts it within
+// 50 years of the reference year, following date-fns.
+func normalizeTwoDigitYear(twoDigitYear, referenceYear int) int {
+	isCommonEra := referenceYear > 0
+	absReference := referenceYear
+	if !isCommonEra {
+		absReference = 1 - referenceYear
+	}
+
+	var result int
+	if absReference <= 50 {
+		result = twoDigitYear
+		if result == 0 {
+			result = 100
+		}
+	} else {
+		rangeEnd := absReference + 50
+		rangeEndCentury := rangeEnd / 100 * 100
+		result = twoDigitYear + rangeEndCentury
+		if twoDigitYear >= rangeEnd%100 {
+			result -= 100
+		}
+	}
+
+	if isCommonEra {
+		return result
+	}
+	return 1 - result
+}
+
+// numberToken parses a numeric field: one-letter tokens accept up to maxDigits
+// digits, longer tokens accept up to their length, and ordinal tokens accept
+// an English ordinal suffix.
+func numberToken(in *tokenInput, tok patternToken, maxDigits int) (int, bool) {
+	if tok.modifier == 'o' {
+		return in.ordinal()
+	}
+	if tok.length > 1 {
+		maxDigits = tok.length
+	}
+	return in.digits(1, maxDigits)
+}
+
+// parseToken parses a single token and returns the setter that applies its value.
+func parseToken(in *tokenInput, tok patternToken, reference time.Time, options *ParseTokensOptions) (tokenSetter, bool) {
+	n := tok.length
+
+	inRange := func(v, lo, hi int) bool { return v >= lo && v <= hi }
+
+	switch tok.letter {
+	case 'G':
+		era, ok := in.name(LocaleEnUS.ErasWide[:], LocaleEnUS.ErasAbbreviated[:], LocaleEnUS.ErasNarrow[:])
+		if !ok {
+			return tokenSetter{}, false
+		}
+		return tokenSetter{priority: 140, apply: func(d *tokenDate, f *tokenFlags) bool {
+			f.era = era
+			return true
+		}}, true
+
+	case 'y', 'Y', 'R', 'u':
+		var value int
+		twoDigit := false
+		switch {
+		case tok.modifier == 'o':
+			v, ok := in.ordinal()
+			if !ok {
+				return tokenSetter{}, false
+			}
+			value = v
+		case n == 2:
+			// Two-letter year tokens read exactly two digits so they can
+			// be followed directly by another numeric field ("yyMMdd").
+			v, ok := in.digits(2, 2)
+			if !ok {
+				return tokenSetter{}, false
+			}
+			value = v
+			twoDigit = tok.letter == 'y' || tok.letter == 'Y'
+		case tok.letter == 'R' || tok.letter == 'u':
+			v, ok := in.signedDigits()
+			if !ok {
+				return tokenSetter{}, false
+			}
+			value = int(v)
+		default:
+			v, ok := in.digits(1, max(n, 4))
+			if !ok {
+				return tokenSetter{}, false
+			}
+			value = v
+		}
+		if twoDigit {
+			value = normalizeTwoDigitYear(value, reference.Year())
+		}
+
+		letter := tok.letter
+		return tokenSetter{priority: 130, apply: func(d *tokenDate, f *tokenFlags) bool {
+			year := value
+			if (letter == 'y' || letter == 'Y') && f.era == 0 {
+				year = 1 - year
+			}
+			d.resetTime()
+			switch letter {
+			case 'Y':
+				firstWeek := options.FirstWeekContainsDate
+				if firstWeek < 1 || firstWeek > 7 {
+					firstWeek = 1
+				}
+				anchor := civilDay(year, time.January, firstWeek)
+				d.setCivilDay(anchor - weekdayOffset(anchor, options.WeekStartsOn))
+			case 'R':
+				anchor := civilDay(year, time.January, 4)
+				d.setCivilDay(anchor - weekdayOffset(anchor, time.Monday))
+			default:
+				d.year, d.month, d.day = year, 1, 1
+			}
+			return true
+		}}, true
+
+	case 'Q', 'q':
+		var quarter int
+		var ok bool
+		switch {
+		case n <= 2:
+			quarter, ok = numberToken(in, tok, 1)
+		case n == 3:
+			if rest := in.rest(); len(rest) > 0 && (rest[0] == 'Q' || rest[0] == 'q') {
+				in.pos++
+				quarter, ok = in.digits(1, 1)
+			}
+		case n == 4:
+			if quarter, ok = in.ordinal(); ok {
+				if rest := in.rest(); len(rest) >= 8 && strings.EqualFold(rest[:8], " quarter") {
+					in.pos += 8
+				} else {
+					ok = false
+				}
+			}
+		default:
+			quarter, ok = in.digits(1, 1)
+		}
+		if !ok || !inRange(quarter, 1, 4) {
+			return tokenSetter{}, false
+		}
+		return tokenSetter{priority: 120, apply: func(d *tokenDate, f *tokenFlags) bool {
+			d.month, d.day = (quarter-1)*3+1, 1
+			d.resetTime()
+			return true
+		}}, true
+
+	case 'M', 'L':
+		var month int
+		var ok bool
+		switch {
+		case n <= 2:
+			month, ok = numberToken(in, tok, 2)
+		case n == 3:
+			month, ok = in.name(LocaleEnUS.MonthsAbbreviated[:], LocaleEnUS.MonthsNarrow[:])
+			month++
+		case n == 4:
+			month, ok = in.name(LocaleEnUS.MonthsWide[:], LocaleEnUS.MonthsAbbreviated[:], LocaleEnUS.MonthsNarrow[:])
+			month++
+		default:
+			month, ok = in.name(LocaleEnUS.MonthsNarrow[:])
+			month++
+		}
+		if !ok || !inRange(month, 1, 12) {
+			return tokenSetter{}, false
+		}
+		return tokenSetter{priority: 110, apply: func(d *tokenDate, f *tokenFlags) bool {
+			d.month, d.day = month, 1
+			d.resetTime()
+			return true
+		}}, true
+
+	case 'w', 'I':
+		week, ok := numberToken(in, tok, 2)
+		if !ok || !inRange(week, 1, 53) {
+			return tokenSetter{}, false
+		}
+		iso := tok.letter == 'I'
+		return tokenSetter{priority: 100, apply: func(d *tokenDate, f *tokenFlags) bool {
+			t := time.Date(d.year, time.Month(d.month), d.day, 0, 0, 0, 0, time.UTC)
+			weekStart, current := time.Monday, 0
+			if iso {
+				_, current = t.ISOWeek()
+			} else {
+				weekStart = options.WeekStartsOn
+				_, current = localWeekYear(t, weekStart, options.FirstWeekContainsDate)
+			}
+			day := d.civilDay() - (current-week)*7
+			d.setCivilDay(day - weekdayOffset(day, weekStart))
+			d.resetTime()
+			return true
+		}}, true
+
+	case 'd':
+		day, ok := numberToken(in, tok, 2)
+		if !ok || !inRange(day, 1, 31) {
+			return tokenSetter{}, false
+		}
+		return tokenSetter{priority: 90, subPriority: 1, apply: func(d *tokenDate, f *tokenFlags) bool {
+			if day > daysIn(d.year, d.month) {
+				return false
+			}
+			d.day = day
+			d.resetTime()
+			return true
+		}}, true
+
+	case 'D':
+		dayOfYear, ok := numberToken(in, tok, 3)
+		if !ok || !inRange(dayOfYear, 1, 366) {
+			return tokenSetter{}, false
+		}
+		return tokenSetter{priority: 90, subPriority: 1, apply: func(d *tokenDate, f *tokenFlags) bool {
+			if dayOfYear == 366 && !IsLeapYear(d.year) {
+				return false
+			}
+			d.setCivilDay(civilDay(d.year, time.January, 1) + dayOfYear - 1)
+			d.resetTime()
+			return true
+		}}, true
+
+	case 'E', 'e', 'c', 'i':
+		var weekday int
+		var ok bool
+		if n <= 2 && tok.letter != 'E' {
+			var value int
+			if value, ok = numberToken(in, tok, 1); !ok || !inRange(value, 1, 7) {
+				return tokenSetter{}, false
+			}
+			if tok.letter == 'i' {
+				weekday = value % 7
+			} else {
+				weekday = (value + int(options.WeekStartsOn) + 6) % 7
+			}
+		} else {
+			weekday, ok = parseWeekdayName(in, n)
+		}
+		if !ok {
+			return tokenSetter{}, false
+		}
+		weekStart := options.WeekStartsOn
+		if tok.letter == 'i' {
+			weekStart = time.Monday
+		}
+		return tokenSetter{priority: 90, apply: func(d *tokenDate, f *tokenFlags) bool {
+			day := d.civilDay()
+			offset := weekdayOffset(day, weekStart)
+			target := (weekday - int(weekStart) + 7) % 7
+			d.setCivilDay(day - offset + target)
+			d.resetTime()
+			return true
+		}}, true
+
+	case 'a', 'b', 'B':
+		hour, ok := parseDayPeriod(in)
+		if !ok {
+			return tokenSetter{}, false
+		}
+		return tokenSetter{priority: 80, apply: func(d *tokenDate, f *tokenFlags) bool {
+			d.hour, d.minute, d.second, d.nanos = hour, 0, 0, 0
+			return true
+		}}, true
+
+	case 'h', 'H', 'K', 'k':
+		hour, ok := numberToken(in, tok, 2)
+		if !ok {
+			return tokenSetter{}, false
+		}
+		letter := tok.letter
+		switch letter {
+		case 'h':
+			ok = inRange(hour, 1, 12)
+		case 'H':
+			ok = inRange(hour, 0, 23)
+		case 'K':
+			ok = inRange(hour, 0, 11)
+		case 'k':
+			ok = inRange(hour, 1, 24)
+		}
+		if !ok {
+			return tokenSetter{}, false
+		}
+		return tokenSetter{priority: 70, apply: func(d *tokenDate, f *tokenFlags) bool {
+			h := hour
+			isPM := d.hour >= 12
+			switch letter {
+			case 'h':
+				if isPM && h < 12 {
+					h += 12
+				} else if !isPM && h == 12 {
+					h = 0
+				}
+			case 'K':
+				if isPM && h < 12 {
+					h += 12
+				}
+			case 'k':
+				h %= 24
+			}
+			d.hour, d.minute, d.second, d.nanos = h, 0, 0, 0
+			return true
+		}}, true
+
+	case 'm':
+		minute, ok := numberToken(in, tok, 2)
+		if !ok || !inRange(minute, 0, 59) {
+			return tokenSetter{}, false
+		}
+		return tokenSetter{priority: 60, apply: func(d *tokenDate, f *tokenFlags) bool {
+			d.minute, d.second, d.nanos = minute, 0, 0
+			return true
+		}}, true
+
+	case 's':
+		second, ok := numberToken(in, tok, 2)
+		if !ok || !inRange(second, 0, 59) {
+			return tokenSetter{}, false
+		}
+		return tokenSetter{priority: 50, apply: func(d *tokenDate, f *tokenFlags) bool {
+			d.second, d.nanos = second, 0
+			return true
+		}}, true
+
+	case 'S':
+		digits := min(n, 9)
+		fraction, ok := in.digits(1, digits)
+		if !ok {
+			return tokenSetter{}, false
+		}
+		for i := digits; i < 9; i++ {
+			fraction *= 10
+		}
+		return tokenSetter{priority: 30, apply: func(d *tokenDate, f *tokenFlags) bool {
+			d.nanos = fraction
+			return true
+		}}, true
+
+	case 'X', 'x':
+		offset, ok := parseOffsetToken(in, n, tok.letter == 'X')
+		if !ok {
+			return tokenSetter{}, false
+		}
+		return tokenSetter{priority: 10, apply: func(d *tokenDate, f *tokenFlags) bool {
+			f.offset, f.hasOffset = offset, true
+			return true
+		}}, true
+
+	case 't', 'T':
+		value, ok := in.signedDigits()
+		if !ok {
+			return tokenSetter{}, false
+		}
+		var instant time.Time
+		if tok.letter == 't' {
+			instant = time.Unix(value, 0)
+		} else {
+			instant = time.UnixMilli(value)
+		}
+		instant = instant.In(reference.Location())
+		return tokenSetter{priority: 40, apply: func(d *tokenDate, f *tokenFlags) bool {
+			d.year, d.month, d.day = instant.Year(), int(instant.Month()), instant.Day()
+			d.hour, d.minute, d.second, d.nanos = instant.Hour(), instant.Minute(), instant.Second(), instant.Nanosecond()
+			f.timestampSet = true
+			return true
+		}}, true
+	}
+
+	return tokenSetter{}, false
+}
+
+// parseWeekdayName parses a weekday name for the given token length, falling back
+// to narrower forms as date-fns does.
+func parseWeekdayName(in *tokenInput, n int) (int, bool) {
+	switch {
+	case n <= 3:
+		return in.name(LocaleEnUS.WeekdaysAbbreviated[:], LocaleEnUS.WeekdaysShort[:], LocaleEnUS.WeekdaysNarrow[:])
+	case n == 4:
+		return in.name(LocaleEnUS.WeekdaysWide[:], LocaleEnUS.WeekdaysAbbreviated[:], LocaleEnUS.WeekdaysShort[:], LocaleEnUS.WeekdaysNarrow[:])
+	case n == 5:
+		return in.name(LocaleEnUS.WeekdaysNarrow[:])
+	default:
+		return in.name(LocaleEnUS.WeekdaysShort[:], LocaleEnUS.WeekdaysNarrow[:])
+	}
+}
+
+// parseDayPeriod parses a meridiem or day period and returns the hour it implies.
+func parseDayPeriod(in *tokenInput) (int, bool) {
+	periods := []string{"a.m.", "p.m.", "am", "pm", "midnight", "noon",
+		"in the morning", "in the afternoon", "in the evening", "at night", "a", "p"}
+	hours := []int{0, 12, 0, 12, 0, 12, 4, 12, 17, 0, 0, 12}
+
+	idx, ok := in.name(periods)
+	if !ok {
+		return 0, false
+	}
+	return hours[idx], true
+}
+
+// parseOffsetToken parses an ISO 8601 zone offset for the X and x token lengths.
+func parseOffsetToken(in *tokenInput, n int, allowZ bool) (int, bool) {
+	rest := in.rest()
+	if allowZ && len(rest) > 0 && rest[0] == 'Z' {
+		in.pos++
+		return 0, true
+	}
+	if len(rest) < 3 || (rest[0] != '+' && rest[0] != '-') {
+		return 0, false
+	}
+	sign := 1
+	if rest[0] == '-' {
+		sign = -1
+	}
+	in.pos++
+
+	hours, ok := in.digits(2, 2)
+	if !ok {
+		return 0, false
+	}
+	minutes := 0
+	switch n {
+	case 1:
+		if m, ok := in.digits(2, 2); ok {
+			minutes = m
+		}
+	case 2, 4:
+		if minutes, ok = in.digits(2, 2); !ok {
+			return 0, false
+		}
+	default:
+		if !strings.HasPrefix(in.rest(), ":") {
+			return 0, false
+		}
+		in.pos++
+		if minutes, ok = in.digits(2, 2); !ok {
+			return 0, false
+		}
+	}
+	if hours > 23 || minutes > 59 {
+		return 0, false
+	}
+	return sign * (hours*3600 + minutes*60), true
+}
+
+// daysIn returns the number of days in the given month.
+func daysIn(year, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
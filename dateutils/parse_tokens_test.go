@@ -0,0 +1,158 @@
+package dateutils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTokens(t *testing.T) {
+	ref := time.Date(2024, time.June, 15, 10, 20, 30, 400, time.UTC)
+	saoPaulo := time.FixedZone("BRT", -3*3600)
+
+	tests := []struct {
+		name      string
+		input     string
+		pattern   string
+		reference time.Time
+		options   *ParseTokensOptions
+		want      time.Time
+		wantErr   bool
+	}{
+		{name: "Day month year", input: "21/03/2024", pattern: "dd/MM/yyyy", reference: ref,
+			want: time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Date and time", input: "21/03/2024 14:05", pattern: "dd/MM/yyyy HH:mm", reference: ref,
+			want: time.Date(2024, 3, 21, 14, 5, 0, 0, time.UTC)},
+		{name: "Single digit fields", input: "3/4/2024", pattern: "d/M/yyyy", reference: ref,
+			want: time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Year only resets finer fields", input: "2020", pattern: "yyyy", reference: ref,
+			want: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Time only keeps reference date", input: "14:30", pattern: "HH:mm", reference: ref,
+			want: time.Date(2024, 6, 15, 14, 30, 0, 0, time.UTC)},
+		{name: "Day only keeps reference month", input: "3", pattern: "d", reference: ref,
+			want: time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Ordinal day and month name", input: "21st March 2024", pattern: "do MMMM yyyy", reference: ref,
+			want: time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Case-insensitive names", input: "thursday, MAR 21", pattern: "EEEE, MMM d", reference: ref,
+			want: time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Quarter", input: "Q3 2024", pattern: "QQQ yyyy", reference: ref,
+			want: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Long quarter", input: "2nd quarter 2023", pattern: "QQQQ yyyy", reference: ref,
+			want: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "ISO week date", input: "2020-W53-5", pattern: "RRRR-'W'II-i", reference: ref,
+			want: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "ISO week date first week", input: "2025-W01-1", pattern: "RRRR-'W'II-i", reference: ref,
+			want: time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{name: "Day of year", input: "2024-060", pattern: "yyyy-DDD", reference: ref,
+			want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "Twelve-hour clock PM", input: "2:05 PM", pattern: "h:mm a", reference: ref,
+			want: time.Date(2024, 6, 15, 14, 5, 0, 0, time.UTC)},
+		{name: "Twelve-hour clock midnight", input: "12:15 am", pattern: "h:mm a", reference: ref,
+			want: time.Date(2024, 6, 15, 0, 15, 0, 0, time.UTC)},
+		{name: "Dotted meridiem", input: "9 p.m.", pattern: "h aaaa", reference: ref,
+			want: time.Date(2024, 6, 15, 21, 0, 0, 0, time.UTC)},
+		{name: "Fraction of second", input: "10:00:01.25", pattern: "HH:mm:ss.SS", reference: ref,
+			want: time.Date(2024, 6, 15, 10, 0, 1, 250000000, time.UTC)},
+		{name: "Offset", input: "2024-03-21T14:05:00+05:30", pattern: "yyyy-MM-dd'T'HH:mm:ssXXX", reference: ref,
+			want: time.Date(2024, 3, 21, 8, 35, 0, 0, time.UTC)},
+		{name: "Z offset", input: "2024-03-21 14:05Z", pattern: "yyyy-MM-dd HH:mmX", reference: ref,
+			want: time.Date(2024, 3, 21, 14, 5, 0, 0, time.UTC)},
+		{name: "Result in reference location", input: "21/03/2024", pattern: "dd/MM/yyyy",
+			reference: time.Date(2024, 1, 1, 0, 0, 0, 0, saoPaulo),
+			want:      time.Date(2024, 3, 21, 0, 0, 0, 0, saoPaulo)},
+		{name: "Two-digit year", input: "03/21/99", pattern: "MM/dd/yy", reference: ref,
+			want: time.Date(1999, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Two-digit year near reference", input: "03/21/30", pattern: "MM/dd/yy", reference: ref,
+			want: time.Date(2030, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Unseparated two-digit year", input: "240321", pattern: "yyMMdd", reference: ref,
+			want: time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Four digits in two-digit year", input: "2024", pattern: "yy", reference: ref, wantErr: true},
+		{name: "Era", input: "44 BC", pattern: "y G", reference: ref,
+			want: time.Date(-43, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Unix timestamp", input: "1711029909", pattern: "t", reference: ref,
+			want: time.Date(2024, 3, 21, 14, 5, 9, 0, time.UTC)},
+		{name: "Localized date", input: "03/21/2024", pattern: "P", reference: ref,
+			want: time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Trailing whitespace allowed", input: "2024-03-21  ", pattern: "yyyy-MM-dd", reference: ref,
+			want: time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Invalid day for month", input: "31/02/2024", pattern: "dd/MM/yyyy", reference: ref, wantErr: true},
+		{name: "Invalid leap day", input: "29/02/2023", pattern: "dd/MM/yyyy", reference: ref, wantErr: true},
+		{name: "Month out of range", input: "13/2024", pattern: "MM/yyyy", reference: ref, wantErr: true},
+		{name: "Literal mismatch", input: "2024.03.21", pattern: "yyyy-MM-dd", reference: ref, wantErr: true},
+		{name: "Trailing text", input: "2024-03-21T10", pattern: "yyyy-MM-dd", reference: ref, wantErr: true},
+		{name: "Unknown token", input: "2024", pattern: "yyyy C", reference: ref, wantErr: true},
+		{name: "Empty input", input: "", pattern: "yyyy", reference: ref, wantErr: true},
+		{name: "Empty pattern", input: "2024", pattern: "", reference: ref, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTokens(tt.input, tt.pattern, tt.reference, tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTokens() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (!got.Equal(tt.want) || got.Location() != tt.want.Location()) {
+				t.Errorf("ParseTokens() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTokensRoundTrip(t *testing.T) {
+	date := time.Date(2024, time.March, 21, 14, 5, 9, 123000000, time.UTC)
+	patterns := []string{
+		"yyyy-MM-dd'T'HH:mm:ss.SSSXXX",
+		"EEEE, MMMM do yyyy 'at' h:mm:ss.SSS a",
+		"dd.MM.yy HH:mm:ss.SSS",
+		"RRRR-'W'II-i HH:mm:ss.SSS",
+		"yyyy-DDD HH:mm:ss.SSS",
+		"PPpp.SSS",
+		"T",
+	}
+
+	for _, pattern := range patterns {
+		t.Run(pattern, func(t *testing.T) {
+			formatted, err := FormatTokens(date, pattern, nil)
+			if err != nil {
+				t.Fatalf("FormatTokens() error = %v", err)
+			}
+			got, err := ParseTokens(formatted, pattern, date, nil)
+			if err != nil {
+				t.Fatalf("ParseTokens(%q) error = %v", formatted, err)
+			}
+			if !got.Equal(date) {
+				t.Errorf("ParseTokens(%q) = %v, want %v", formatted, got, date)
+			}
+		})
+	}
+}
+
+func TestIsMatch(t *testing.T) {
+	tests := []struct {
+		input   string
+		pattern string
+		want    bool
+	}{
+		{"21/03/2024", "dd/MM/yyyy", true},
+		{"31/02/2024", "dd/MM/yyyy", false},
+		{"2024-03-21", "dd/MM/yyyy", false},
+		{"Mar 21, 2024", "MMM d, yyyy", true},
+		{"Foo 21, 2024", "MMM d, yyyy", false},
+		{"10:30 pm", "hh:mm a", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := IsMatch(tt.input, tt.pattern, nil); got != tt.want {
+				t.Errorf("IsMatch(%q, %q) = %v, want %v", tt.input, tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkParseTokens(b *testing.B) {
+	ref := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseTokens("21/03/2024 14:05", "dd/MM/yyyy HH:mm", ref, nil)
+	}
+}
@@ -0,0 +1,347 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DateOrder is the preferred order of the year, month and day fields when a
+// numeric date such as "03/04/2024" matches more than one layout.
+type DateOrder int
+
+const (
+	// PreferLayoutOrder tries layouts in the order they were given.
+	PreferLayoutOrder DateOrder = iota
+	// PreferMDY tries month-first layouts (01/02/2006) before the others.
+	PreferMDY
+	// PreferDMY tries day-first layouts (02/01/2006) before the others.
+	PreferDMY
+	// PreferYMD tries year-month-day layouts (2006/01/02) before the others.
+	PreferYMD
+
+	// dateOrderYDM classifies year-day-month layouts; it cannot be preferred.
+	dateOrderYDM DateOrder = -1
+)
+
+// ParserOptions configures a Parser.
+type ParserOptions struct {
+	// Layouts is the ordered list of Go reference layouts to try.
+	// Nil or empty uses the layouts in CommonDateFormats.
+	Layouts []string
+
+	// Location is used for inputs without a zone or offset, and results are
+	// returned in it. Nil means UTC.
+	Location *time.Location
+
+	// DateOrder moves the layouts of the preferred numeric field order ahead
+	// of the others. The zero value keeps the order of Layouts.
+	DateOrder DateOrder
+
+	// RejectAmbiguous makes Parse try every layout and return an error
+	// wrapping ErrAmbiguousDate when layouts match with different results.
+	// A DateOrder other than PreferLayoutOrder settles the ambiguity when
+	// exactly one result comes from layouts in the preferred order.
+	RejectAmbiguous bool
+
+	// TwoDigitYearPivot places two-digit years below the pivot in the 2000s
+	// and the rest in the 1900s. Zero, or a value outside 1-100, keeps Go's
+	// pivot of 69.
+	TwoDigitYearPivot int
+
+	// Locales lets Parse read month and weekday names in these languages, as
+	// in "2 de janeiro de 2024" or "lun. 5 févr. 2024". Names are matched
+	// case- and accent-insensitively, abbreviations with or without their
+	// dot, and the Locale's Connectors are skipped. When two locales spell
+	// different months the same way, the earlier one wins.
+	Locales []*Locale
+}
+
+// Parser parses date strings with its own ordered layout list and rules.
+// A Parser is immutable once built and safe for concurrent use, so different
+// callers can keep independent configurations without touching shared state.
+//
+// Example:
+//
+//	parser := NewParser(&ParserOptions{
+//		Layouts:   []string{"01/02/2006", "02/01/2006"},
+//		DateOrder: PreferDMY,
+//	})
+//	date, _ := parser.Parse("03/04/2024") // 3 April 2024
+type Parser struct {
+	layouts         []parserLayout
+	location        *time.Location
+	pivot           int
+	order           DateOrder
+	rejectAmbiguous bool
+	names           *nameNormalizer // Nil without Locales
+}
+
+// parserLayout is a layout with the field information the Parser needs.
+type parserLayout struct {
+	layout string
+	info   layoutInfo
+}
+
+// defaultParser backs Parse. It is built from CommonDateFormats at package
+// initialization, so later changes to that slice do not affect Parse.
+var defaultParser = NewParser(nil)
+
+// NewParser returns a Parser configured by options.
+// Options can be nil to use the default configuration, which matches Parse.
+func NewParser(options *ParserOptions) *Parser {
+	if options == nil {
+		options = &ParserOptions{}
+	}
+
+	layouts := options.Layouts
+	if len(layouts) == 0 {
+		layouts = CommonDateFormats
+	}
+
+	p := &Parser{
+		layouts:         make([]parserLayout, len(layouts)),
+		location:        options.Location,
+		order:           options.DateOrder,
+		rejectAmbiguous: options.RejectAmbiguous,
+	}
+	if p.location == nil {
+		p.location = time.UTC
+	}
+	if options.TwoDigitYearPivot > 0 && options.TwoDigitYearPivot <= 100 {
+		p.pivot = options.TwoDigitYearPivot
+	}
+
+	if len(options.Locales) > 0 {
+		p.names = newNameNormalizer(options.Locales)
+	}
+
+	for i, layout := range layouts {
+		p.layouts[i] = parserLayout{layout: layout, info: inspectLayout(layout)}
+	}
+
+	// Move the layouts of the non-preferred numeric order to the back,
+	// keeping the relative order of everything else.
+	if options.DateOrder != PreferLayoutOrder {
+		sort.SliceStable(p.layouts, func(i, j int) bool {
+			return p.layouts[i].info.rank(options.DateOrder) < p.layouts[j].info.rank(options.DateOrder)
+		})
+	}
+
+	return p
+}
+
+// Layouts returns a copy of the layouts the Parser tries, in order.
+func (p *Parser) Layouts() []string {
+	layouts := make([]string, len(p.layouts))
+	for i, l := range p.layouts {
+		layouts[i] = l.layout
+	}
+	return layouts
+}
+
+// Location returns the location the Parser uses for zone-less inputs and results.
+func (p *Parser) Location() *time.Location {
+	return p.location
+}
+
+// Parse parses a date string with the Parser's layouts and returns the first
+// successful result, converted to the Parser's location.
+// With Locales, an input no layout matches is tried again with its month
+// names translated to English and its weekday names and connector words removed.
+// Native digits, such as Arabic-Indic or Devanagari ones, are read as ASCII
+// digits, and errors report the input as given, with byte offsets into it.
+// Returns a *ParseError listing every layout tried if the string cannot be parsed,
+// or an error wrapping ErrEmptyInput for an empty string.
+//
+// Example:
+//
+//	parser := NewParser(&ParserOptions{Location: time.Local})
+//	date, err := parser.Parse("2024-03-21 14:05:00")
+//
+//	parser = NewParser(&ParserOptions{Locales: []*Locale{LocalePtBR, LocaleDE}})
+//	date, err = parser.Parse("2 de janeiro de 2024") // 2 January 2024
+//	date, err = parser.Parse("3. März 2024")         // 3 March 2024
+//	date, err = parser.Parse("٢٠٢٤-٠٣-٢١")           // 21 March 2024
+func (p *Parser) Parse(input string) (time.Time, error) {
+	if input == "" {
+		return time.Time{}, fmt.Errorf("cannot parse date string: %w", ErrEmptyInput)
+	}
+	text := NormalizeDigits(input)
+
+	result, err := p.parse(text)
+	var parseErr *ParseError
+	if err == nil || p.names == nil || !errors.As(err, &parseErr) {
+		return result, restoreInput(err, input)
+	}
+	// Errors of the rewritten input would point into a string the caller
+	// never saw, so only its successes and ambiguities are returned.
+	if normalized, ok := p.names.normalize(text); ok {
+		if result, namesErr := p.parse(normalized); !errors.As(namesErr, &parseErr) {
+			return result, restoreInput(namesErr, input)
+		}
+	}
+	return time.Time{}, restoreInput(err, input)
+}
+
+// parse tries the Parser's layouts on input.
+func (p *Parser) parse(input string) (time.Time, error) {
+	attempts := make([]LayoutAttempt, 0, len(p.layouts))
+	var candidates []DateCandidate
+	var ranks []int
+
+	for _, l := range p.layouts {
+		parsedTime, err := time.ParseInLocation(l.layout, input, p.location)
+		if err != nil {
+			attempts = append(attempts, layoutAttempt(l.layout, input, err))
+			continue
+		}
+		if l.info.twoDigitYear && p.pivot > 0 {
+			parsedTime = p.applyPivot(parsedTime)
+		}
+		parsedTime = parsedTime.In(p.location)
+		if !p.rejectAmbiguous {
+			return parsedTime, nil
+		}
+
+		candidates = append(candidates, DateCandidate{Layout: l.layout, Time: parsedTime})
+		ranks = append(ranks, l.info.rank(p.order))
+	}
+
+	if len(candidates) == 0 {
+		return time.Time{}, newParseError(input, attempts)
+	}
+	if agree(candidates, ranks, 1) {
+		return candidates[0].Time, nil
+	}
+	// A preference settles the ambiguity when the preferred layouts agree.
+	// Layouts are sorted by rank, so the first candidate is a preferred one.
+	if p.order != PreferLayoutOrder && ranks[0] == 0 && agree(candidates, ranks, 0) {
+		return candidates[0].Time, nil
+	}
+
+	return time.Time{}, &AmbiguousDateError{Input: input, Candidates: candidates}
+}
+
+// agree reports whether every candidate with a rank of at most maxRank
+// resolved to the same instant as the first one.
+func agree(candidates []DateCandidate, ranks []int, maxRank int) bool {
+	for i := 1; i < len(candidates); i++ {
+		if ranks[i] <= maxRank && !candidates[i].Time.Equal(candidates[0].Time) {
+			return false
+		}
+	}
+	return true
+}
+
+// applyPivot moves a time parsed from a two-digit year into the century
+// chosen by the Parser's pivot. Year 00 always lands in 2000, so a leap day
+// stays valid when the century changes.
+func (p *Parser) applyPivot(t time.Time) time.Time {
+	yy := t.Year() % 100
+	year := 1900 + yy
+	if yy < p.pivot {
+		year = 2000 + yy
+	}
+	return t.AddDate(year-t.Year(), 0, 0)
+}
+
+// layoutInfo describes the date fields of a Go reference layout.
+type layoutInfo struct {
+	order        DateOrder // Field order of a numeric date, PreferLayoutOrder if none
+	twoDigitYear bool      // Year is written as 06 with no four-digit year
+}
+
+// rank orders layouts for a DateOrder: 1 for numeric layouts in another
+// order, 0 for everything else.
+func (info layoutInfo) rank(order DateOrder) int {
+	if info.order != PreferLayoutOrder && info.order != order {
+		return 1
+	}
+	return 0
+}
+
+// inspectLayout scans a Go reference layout for its month, day and year fields,
+// skipping the time, weekday, zone and fraction elements.
+func inspectLayout(layout string) layoutInfo {
+	var info layoutInfo
+	monthAt, dayAt, yearAt := -1, -1, -1
+	numericMonth, fourDigitYear := false, false
+
+	for i := 0; i < len(layout); {
+		rest := layout[i:]
+		switch {
+		case strings.HasPrefix(rest, "January"):
+			monthAt, i = firstIndex(monthAt, i), i+7
+		case strings.HasPrefix(rest, "Jan"):
+			monthAt, i = firstIndex(monthAt, i), i+3
+		case strings.HasPrefix(rest, "Monday"):
+			i += 6
+		case strings.HasPrefix(rest, "Mon"), strings.HasPrefix(rest, "MST"):
+			i += 3
+		case strings.HasPrefix(rest, "2006"):
+			yearAt, fourDigitYear, i = firstIndex(yearAt, i), true, i+4
+		case strings.HasPrefix(rest, "__2"), strings.HasPrefix(rest, "002"):
+			i += 3
+		case strings.HasPrefix(rest, "_2"), strings.HasPrefix(rest, "02"):
+			dayAt, i = firstIndex(dayAt, i), i+2
+		case strings.HasPrefix(rest, "01"):
+			if monthAt < 0 {
+				monthAt, numericMonth = i, true
+			}
+			i += 2
+		case strings.HasPrefix(rest, "06"):
+			yearAt, info.twoDigitYear, i = firstIndex(yearAt, i), true, i+2
+		case strings.HasPrefix(rest, "15"), strings.HasPrefix(rest, "03"),
+			strings.HasPrefix(rest, "04"), strings.HasPrefix(rest, "05"):
+			i += 2
+		case strings.HasPrefix(rest, "-07"), strings.HasPrefix(rest, "Z07"):
+			// Numeric zone offsets: -07, -0700, -07:00, -070000, Z07:00, ...
+			i++
+			for i < len(layout) && (layout[i] >= '0' && layout[i] <= '9' || layout[i] == ':') {
+				i++
+			}
+		case (rest[0] == '.' || rest[0] == ',') && len(rest) > 1 && (rest[1] == '0' || rest[1] == '9'):
+			digit := rest[1]
+			i++
+			for i < len(layout) && layout[i] == digit {
+				i++
+			}
+		case rest[0] == '1':
+			if monthAt < 0 {
+				monthAt, numericMonth = i, true
+			}
+			i++
+		case rest[0] == '2':
+			dayAt, i = firstIndex(dayAt, i), i+1
+		default:
+			i++
+		}
+	}
+
+	// Only dates with a numeric month and a day can be read in another order.
+	if numericMonth && dayAt >= 0 {
+		switch {
+		case yearAt >= 0 && yearAt < monthAt && yearAt < dayAt && monthAt < dayAt:
+			info.order = PreferYMD
+		case yearAt >= 0 && yearAt < monthAt && yearAt < dayAt:
+			info.order = dateOrderYDM
+		case monthAt < dayAt:
+			info.order = PreferMDY
+		default:
+			info.order = PreferDMY
+		}
+	}
+	info.twoDigitYear = info.twoDigitYear && !fourDigitYear
+	return info
+}
+
+// firstIndex returns current if it is already set, otherwise i.
+func firstIndex(current, i int) int {
+	if current >= 0 {
+		return current
+	}
+	return i
+}
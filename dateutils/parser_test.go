@@ -0,0 +1,277 @@
+package dateutils
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewParser(t *testing.T) {
+	if got := NewParser(nil).Layouts(); len(got) != len(CommonDateFormats) {
+		t.Errorf("NewParser(nil) has %d layouts, want %d", len(got), len(CommonDateFormats))
+	}
+
+	layouts := []string{"01/02/2006", "2006-01-02", "02/01/2006"}
+	parser := NewParser(&ParserOptions{Layouts: layouts, DateOrder: PreferDMY})
+	want := []string{"02/01/2006", "01/02/2006", "2006-01-02"}
+	got := parser.Layouts()
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Layouts() = %v, want %v", got, want)
+		}
+	}
+
+	// The Parser must not share storage with the options or its callers.
+	layouts[0] = "changed"
+	got[0] = "changed"
+	if parser.Layouts()[0] != "02/01/2006" || NewParser(&ParserOptions{Layouts: []string{"2006"}}).Location() != time.UTC {
+		t.Error("Parser shares state with its options or the Layouts() result")
+	}
+}
+
+func TestParserParse(t *testing.T) {
+	saoPaulo := time.FixedZone("BRT", -3*3600)
+	numeric := []string{"01/02/2006", "02/01/2006"}
+
+	tests := []struct {
+		name    string
+		options *ParserOptions
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Default layouts", input: "2024-03-21",
+			want: time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Layout order", options: &ParserOptions{Layouts: numeric}, input: "03/04/2024",
+			want: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{name: "Prefer MDY", options: &ParserOptions{Layouts: []string{"02/01/2006", "01/02/2006"}, DateOrder: PreferMDY},
+			input: "03/04/2024", want: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{name: "Prefer DMY", options: &ParserOptions{Layouts: numeric, DateOrder: PreferDMY}, input: "03/04/2024",
+			want: time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Prefer DMY falls back to MDY", options: &ParserOptions{Layouts: numeric, DateOrder: PreferDMY},
+			input: "03/25/2024", want: time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC)},
+		{name: "Zone-less input in location", options: &ParserOptions{Location: saoPaulo}, input: "2024-03-21 14:05:00",
+			want: time.Date(2024, 3, 21, 14, 5, 0, 0, saoPaulo)},
+		{name: "Offset input converted to location", options: &ParserOptions{Location: saoPaulo}, input: "2024-03-21T14:05:00Z",
+			want: time.Date(2024, 3, 21, 11, 5, 0, 0, saoPaulo)},
+		{name: "Go two-digit year pivot", options: &ParserOptions{Layouts: []string{"02/01/06"}}, input: "21/03/50",
+			want: time.Date(2050, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Custom pivot to 1900s", options: &ParserOptions{Layouts: []string{"02/01/06"}, TwoDigitYearPivot: 30}, input: "21/03/50",
+			want: time.Date(1950, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Custom pivot to 2000s", options: &ParserOptions{Layouts: []string{"02/01/06"}, TwoDigitYearPivot: 80}, input: "21/03/75",
+			want: time.Date(2075, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "Pivot ignores four-digit years", options: &ParserOptions{Layouts: []string{"2006-01-02"}, TwoDigitYearPivot: 30}, input: "2050-03-21",
+			want: time.Date(2050, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "No layout matches", options: &ParserOptions{Layouts: numeric}, input: "2024-03-21", wantErr: true},
+		{name: "Empty input", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewParser(tt.options).Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (!got.Equal(tt.want) || got.Location() != tt.want.Location()) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewParser(nil).Parse(""); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("Parse(\"\") error = %v, want wrapped ErrEmptyInput", err)
+	}
+}
+
+func TestParserLocales(t *testing.T) {
+	european := &ParserOptions{Locales: []*Locale{LocalePtBR, LocaleDE, LocaleFR, LocaleES, LocaleIT}}
+
+	tests := []struct {
+		name    string
+		options *ParserOptions
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Portuguese connectors", options: european, input: "2 de janeiro de 2024",
+			want: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "Portuguese weekday and ordinal", options: european, input: "segunda-feira, 1º de janeiro de 2024",
+			want: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "German day with dot", options: european, input: "3. März 2024",
+			want: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "German weekday", options: european, input: "Donnerstag, 21. März 2024",
+			want: time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "French abbreviations", options: european, input: "lun. 5 févr. 2024",
+			want: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "French ordinal", options: european, input: "le 1er mars 2024",
+			want: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Case and accents ignored", options: european, input: "5 FEVRIER 2024",
+			want: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "Abbreviation without dot", options: european, input: "5 fevr 2024",
+			want: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "Spanish weekday that is also a month", options: european, input: "mar, 5 de marzo de 2024",
+			want: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "Spanish month that is also a weekday", options: european, input: "5 mar 2024",
+			want: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "Spanish weekday and month with the same abbreviation", options: &ParserOptions{Locales: []*Locale{LocaleES}},
+			input: "mar. 5 mar. 2024", want: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "Spanish month first", options: &ParserOptions{Locales: []*Locale{LocaleES}}, input: "mar 5, 2024",
+			want: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "Month-first order", options: european, input: "marzo 5, 2024",
+			want: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "Polish genitive", options: &ParserOptions{Locales: []*Locale{LocalePL}}, input: "3 marca 2024 r.",
+			want: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Russian genitive", options: &ParserOptions{Locales: []*Locale{LocaleRU}}, input: "3 марта 2024 г.",
+			want: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "English still parsed", options: european, input: "Jan 2, 2024",
+			want: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "Locale not configured", options: &ParserOptions{Locales: []*Locale{LocaleDE}}, input: "2 de janeiro de 2024", wantErr: true},
+		{name: "No locales", input: "3. März 2024", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewParser(tt.options).Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	// Errors describe the input as given, not its rewritten form.
+	_, err := NewParser(european).Parse("32 de janeiro de 2024")
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Input != "32 de janeiro de 2024" {
+		t.Errorf("Parse() error = %v, want *ParseError for the original input", err)
+	}
+}
+
+func TestParserRejectAmbiguous(t *testing.T) {
+	ymd := []string{"2006/01/02", "2006/02/01"}
+
+	tests := []struct {
+		name           string
+		options        *ParserOptions
+		input          string
+		want           time.Time
+		wantCandidates int
+	}{
+		{name: "Ambiguous day and month", options: &ParserOptions{}, input: "03/04/2024", wantCandidates: 2},
+		{name: "Same day and month", options: &ParserOptions{}, input: "03/03/2024",
+			want: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Only one reading valid", options: &ParserOptions{}, input: "25/03/2024",
+			want: time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC)},
+		{name: "Layouts agreeing on the instant", options: &ParserOptions{}, input: "2024-03-21T10:00:00Z",
+			want: time.Date(2024, 3, 21, 10, 0, 0, 0, time.UTC)},
+		{name: "Prefer DMY settles", options: &ParserOptions{DateOrder: PreferDMY}, input: "03/04/2024",
+			want: time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Prefer MDY settles", options: &ParserOptions{DateOrder: PreferMDY}, input: "03-04-2024",
+			want: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{name: "Prefer YMD settles", options: &ParserOptions{Layouts: ymd, DateOrder: PreferYMD}, input: "2024/03/04",
+			want: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{name: "Prefer YMD does not settle DMY and MDY", options: &ParserOptions{DateOrder: PreferYMD}, input: "03/04/2024",
+			wantCandidates: 2},
+		{name: "Year-day-month layout", options: &ParserOptions{Layouts: ymd}, input: "2024/03/04", wantCandidates: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.options.RejectAmbiguous = true
+			got, err := NewParser(tt.options).Parse(tt.input)
+			if tt.wantCandidates == 0 {
+				if err != nil {
+					t.Fatalf("Parse() unexpected error = %v", err)
+				}
+				if !got.Equal(tt.want) {
+					t.Errorf("Parse() = %v, want %v", got, tt.want)
+				}
+				return
+			}
+
+			if !errors.Is(err, ErrAmbiguousDate) {
+				t.Fatalf("Parse() error = %v, want wrapped ErrAmbiguousDate", err)
+			}
+			var ambiguous *AmbiguousDateError
+			if !errors.As(err, &ambiguous) {
+				t.Fatalf("Parse() error is not an *AmbiguousDateError: %T", err)
+			}
+			if ambiguous.Input != tt.input || len(ambiguous.Candidates) != tt.wantCandidates {
+				t.Errorf("AmbiguousDateError = %+v, want %d candidates for %q", ambiguous, tt.wantCandidates, tt.input)
+			}
+		})
+	}
+
+	if _, err := NewParser(&ParserOptions{RejectAmbiguous: true}).Parse("not-a-date"); errors.Is(err, ErrAmbiguousDate) {
+		t.Errorf("Parse() of an invalid date returned %v, want a *ParseError", err)
+	}
+}
+
+func TestInspectLayout(t *testing.T) {
+	tests := []struct {
+		layout       string
+		order        DateOrder
+		twoDigitYear bool
+	}{
+		{"01/02/2006", PreferMDY, false},
+		{"02-01-2006 15:04:05", PreferDMY, false},
+		{"2/1/06", PreferDMY, true},
+		{"Jan _2 15:04:05.000 -0700", PreferLayoutOrder, false},
+		{"2006-01-02", PreferYMD, false},
+		{"2006/02/01", dateOrderYDM, false},
+		{"2 January 2006", PreferLayoutOrder, false},
+		{time.RFC3339Nano, PreferYMD, false},
+		{"01/2006", PreferLayoutOrder, false},
+		{"Monday, 01/02 MST", PreferMDY, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			info := inspectLayout(tt.layout)
+			if info.order != tt.order || info.twoDigitYear != tt.twoDigitYear {
+				t.Errorf("inspectLayout() = %+v, want order %v twoDigitYear %v", info, tt.order, tt.twoDigitYear)
+			}
+		})
+	}
+}
+
+func TestParserConcurrent(t *testing.T) {
+	mdy := NewParser(&ParserOptions{DateOrder: PreferMDY})
+	dmy := NewParser(&ParserOptions{DateOrder: PreferDMY})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				a, errA := mdy.Parse("03/04/2024")
+				b, errB := dmy.Parse("03/04/2024")
+				if errA != nil || errB != nil || a.Month() != time.March || b.Month() != time.April {
+					t.Errorf("concurrent Parse() = %v, %v (%v, %v)", a, b, errA, errB)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkParserParse(b *testing.B) {
+	parser := NewParser(&ParserOptions{DateOrder: PreferDMY})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = parser.Parse("21/03/2024")
+	}
+}
+
+func BenchmarkParserParseLocales(b *testing.B) {
+	parser := NewParser(&ParserOptions{Locales: []*Locale{LocalePtBR, LocaleDE, LocaleFR}})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = parser.Parse("2 de janeiro de 2024")
+	}
+}
@@ -0,0 +1,380 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period is a calendar-aware duration, such as the ISO 8601 duration
+// "P1Y2M10DT2H30M". Unlike time.Duration it can hold years, months and days,
+// whose length depends on the date they are added to.
+type Period struct {
+	Years   int
+	Months  int
+	Weeks   int
+	Days    int
+	Hours   int
+	Minutes int
+	Seconds int
+	Nanos   int
+}
+
+// periodLayout is the layout name reported in ParsePeriod errors.
+const periodLayout = "ISO 8601 duration"
+
+// ParsePeriod parses an ISO 8601 duration such as "P1Y2M10DT2H30M", "P3W"
+// or "PT0.5S". Components must appear in order, and the lowest-order time
+// component may have a decimal fraction written with "." or ",".
+// A leading "-" negates the whole period, and a "-" before a single
+// component negates only that component.
+// Returns a *ParseError if the string is not a valid duration,
+// or an error wrapping ErrEmptyInput for an empty string.
+//
+// Example:
+//
+//	period, _ := ParsePeriod("P1Y2M10DT2H30M")
+//	// Period{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}
+func ParsePeriod(s string) (Period, error) {
+	if s == "" {
+		return Period{}, fmt.Errorf("cannot parse duration: %w", ErrEmptyInput)
+	}
+
+	period, offset, err := parsePeriod(s)
+	if err != nil {
+		return Period{}, newParseError(s, []LayoutAttempt{{Layout: periodLayout, Offset: offset, Err: err}})
+	}
+	return period, nil
+}
+
+// parsePeriod parses s, returning the offset of the failing element on error.
+func parsePeriod(s string) (Period, int, error) {
+	var p Period
+	pos := 0
+
+	negative := false
+	if s[pos] == '-' || s[pos] == '+' {
+		negative = s[pos] == '-'
+		pos++
+	}
+	if pos >= len(s) || (s[pos] != 'P' && s[pos] != 'p') {
+		return p, pos, errors.New(`expected "P"`)
+	}
+	pos++
+
+	// Designators in order; the time designators follow "T".
+	dateUnits := []byte{'Y', 'M', 'W', 'D'}
+	timeUnits := []byte{'H', 'M', 'S'}
+	units, next := dateUnits, 0
+	inTime, components, fractional := false, 0, false
+
+	for pos < len(s) {
+		if s[pos] == 'T' || s[pos] == 't' {
+			if inTime {
+				return p, pos, errors.New(`duplicate "T"`)
+			}
+			inTime, units, next = true, timeUnits, 0
+			pos++
+			if pos == len(s) {
+				return p, pos, errors.New(`expected a time component after "T"`)
+			}
+			continue
+		}
+		if fractional {
+			return p, pos, errors.New("only the lowest-order component can have a fraction")
+		}
+
+		start := pos
+		sign := 1
+		if s[pos] == '-' {
+			sign = -1
+			pos++
+		}
+		digitsStart := pos
+		for pos < len(s) && isDigit(s[pos]) {
+			pos++
+		}
+		if pos == digitsStart {
+			return p, start, errors.New("expected a number")
+		}
+		value, err := strconv.Atoi(s[digitsStart:pos])
+		if err != nil {
+			return p, digitsStart, fmt.Errorf("number %s out of range", s[digitsStart:pos])
+		}
+
+		nanos := 0
+		if pos < len(s) && (s[pos] == '.' || s[pos] == ',') {
+			pos++
+			fractionStart := pos
+			for i := 0; i < 9; i++ {
+				nanos *= 10
+				if pos < len(s) && isDigit(s[pos]) {
+					nanos += int(s[pos] - '0')
+					pos++
+				}
+			}
+			for pos < len(s) && isDigit(s[pos]) {
+				pos++
+			}
+			if pos == fractionStart {
+				return p, pos, errors.New("expected fraction digits")
+			}
+			fractional = true
+		}
+
+		if pos == len(s) {
+			return p, pos, errors.New("expected a designator")
+		}
+		designator := s[pos]
+		if designator >= 'a' && designator <= 'z' {
+			designator -= 'a' - 'A'
+		}
+		index := strings.IndexByte(string(units[next:]), designator)
+		if index < 0 {
+			return p, pos, fmt.Errorf("unexpected designator %q", s[pos])
+		}
+		next += index + 1
+		pos++
+		components++
+
+		if fractional && !inTime {
+			return p, start, errors.New("fractions are only allowed on hours, minutes and seconds")
+		}
+
+		value *= sign
+		nanos *= sign
+		switch {
+		case !inTime && designator == 'Y':
+			p.Years = value
+		case !inTime && designator == 'M':
+			p.Months = value
+		case designator == 'W':
+			p.Weeks = value
+		case designator == 'D':
+			p.Days = value
+		case designator == 'H':
+			p.Hours = value
+			p.Nanos = nanos * 3600
+		case designator == 'M':
+			p.Minutes = value
+			p.Nanos = nanos * 60
+		case designator == 'S':
+			p.Seconds = value
+			p.Nanos = nanos
+		}
+	}
+
+	if components == 0 {
+		return p, pos, errors.New("expected at least one component")
+	}
+	if negative {
+		p = p.Negate()
+	}
+	return p.carryNanos(), 0, nil
+}
+
+// FormatISODuration formats a Period as an ISO 8601 duration, omitting zero
+// components: Period{Years: 1, Days: 10, Minutes: 30} is "P1Y10DT30M" and the
+// zero Period is "PT0S". A Period whose components are all negative is
+// written with a leading "-"; mixed signs are written per component.
+// Weeks are written as a separate "W" component, as ISO 8601-2 allows.
+//
+// Example:
+//
+//	FormatISODuration(Period{Weeks: 3})               // "P3W"
+//	FormatISODuration(Period{Hours: -1, Minutes: -30}) // "-PT1H30M"
+func FormatISODuration(p Period) string {
+	if p.IsZero() {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	if p.sign() < 0 {
+		b.WriteByte('-')
+		p = p.Negate()
+	}
+	b.WriteByte('P')
+
+	write := func(value int, designator byte) {
+		if value != 0 {
+			b.WriteString(strconv.Itoa(value))
+			b.WriteByte(designator)
+		}
+	}
+	write(p.Years, 'Y')
+	write(p.Months, 'M')
+	write(p.Weeks, 'W')
+	write(p.Days, 'D')
+
+	// Seconds and nanoseconds are written together as a decimal.
+	totalNanos := int64(p.Seconds)*int64(time.Second) + int64(p.Nanos)
+	if p.Hours != 0 || p.Minutes != 0 || totalNanos != 0 {
+		b.WriteByte('T')
+		write(p.Hours, 'H')
+		write(p.Minutes, 'M')
+		if totalNanos != 0 {
+			if totalNanos < 0 {
+				b.WriteByte('-')
+				totalNanos = -totalNanos
+			}
+			b.WriteString(strconv.FormatInt(totalNanos/int64(time.Second), 10))
+			if fraction := totalNanos % int64(time.Second); fraction != 0 {
+				digits := strconv.FormatInt(fraction+int64(time.Second), 10)[1:]
+				b.WriteByte('.')
+				b.WriteString(strings.TrimRight(digits, "0"))
+			}
+			b.WriteByte('S')
+		}
+	}
+	return b.String()
+}
+
+// String returns the ISO 8601 representation of the Period.
+func (p Period) String() string {
+	return FormatISODuration(p)
+}
+
+// IsZero reports whether every component of the Period is zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns the Period with every component negated.
+func (p Period) Negate() Period {
+	return Period{
+		Years:   -p.Years,
+		Months:  -p.Months,
+		Weeks:   -p.Weeks,
+		Days:    -p.Days,
+		Hours:   -p.Hours,
+		Minutes: -p.Minutes,
+		Seconds: -p.Seconds,
+		Nanos:   -p.Nanos,
+	}
+}
+
+// Normalize carries overflowing components into larger ones where the
+// conversion is exact: nanoseconds into seconds, seconds into minutes,
+// minutes into hours and months into years. Days, weeks and hours are left
+// alone, since a day is not always 24 hours. Each component keeps its sign.
+//
+// Example:
+//
+//	Period{Months: 14, Minutes: 90}.Normalize() // Period{Years: 1, Months: 2, Hours: 1, Minutes: 30}
+func (p Period) Normalize() Period {
+	p = p.carryNanos()
+	p.Minutes += p.Seconds / 60
+	p.Seconds %= 60
+	p.Hours += p.Minutes / 60
+	p.Minutes %= 60
+	p.Years += p.Months / 12
+	p.Months %= 12
+	return p
+}
+
+// times returns the Period with every component multiplied by n.
+func (p Period) times(n int) Period {
+	return Period{
+		Years:   p.Years * n,
+		Months:  p.Months * n,
+		Weeks:   p.Weeks * n,
+		Days:    p.Days * n,
+		Hours:   p.Hours * n,
+		Minutes: p.Minutes * n,
+		Seconds: p.Seconds * n,
+		Nanos:   p.Nanos * n,
+	}
+}
+
+// carryNanos moves whole seconds out of Nanos.
+func (p Period) carryNanos() Period {
+	p.Seconds += p.Nanos / int(time.Second)
+	p.Nanos %= int(time.Second)
+	return p
+}
+
+// sign returns -1 if every non-zero component is negative, 1 otherwise.
+func (p Period) sign() int {
+	for _, value := range []int{p.Years, p.Months, p.Weeks, p.Days, p.Hours, p.Minutes, p.Seconds, p.Nanos} {
+		if value > 0 {
+			return 1
+		}
+	}
+	return -1
+}
+
+// AddPeriod adds a Period to the given time in date-fns add order: years and
+// months together with AddMonths (so Jan 31 + 1 month is the last day of
+// February), then weeks and days as calendar days, then the time components
+// as elapsed time.
+// Returns a new time.Time instance, leaving the original unchanged (immutable).
+//
+// Example:
+//
+//	start := time.Date(2024, time.January, 31, 10, 0, 0, 0, time.UTC)
+//	AddPeriod(start, Period{Months: 1, Hours: 2}) // 2024-02-29 12:00:00
+func AddPeriod(t time.Time, p Period) time.Time {
+	result := t
+	if months := p.Years*12 + p.Months; months != 0 {
+		result = AddMonths(result, months)
+	}
+	if days := p.Weeks*7 + p.Days; days != 0 {
+		result = AddDays(result, days)
+	}
+	return result.Add(time.Duration(p.Hours)*time.Hour +
+		time.Duration(p.Minutes)*time.Minute +
+		time.Duration(p.Seconds)*time.Second +
+		time.Duration(p.Nanos))
+}
+
+// SubPeriod subtracts a Period from the given time, in the same order as AddPeriod.
+// Returns a new time.Time instance, leaving the original unchanged (immutable).
+func SubPeriod(t time.Time, p Period) time.Time {
+	return AddPeriod(t, p.Negate())
+}
+
+// IntervalToPeriod breaks an interval into years, months, days, hours,
+// minutes, seconds and nanoseconds, like date-fns intervalToDuration.
+// Each component is the largest that still fits, so adding the result to
+// the start with AddPeriod gives the end. Weeks are never used.
+// If the interval ends before it starts, every component is negative.
+//
+// Example:
+//
+//	IntervalToPeriod(Interval{
+//		Start: time.Date(1929, time.January, 15, 12, 0, 0, 0, time.UTC),
+//		End:   time.Date(1968, time.April, 4, 19, 5, 0, 0, time.UTC),
+//	})
+//	// Period{Years: 39, Months: 2, Days: 20, Hours: 7, Minutes: 5}
+func IntervalToPeriod(interval Interval) Period {
+	start, end := interval.Start, interval.End
+	if start.After(end) {
+		return IntervalToPeriod(Interval{Start: end, End: start}).Negate()
+	}
+
+	// Months are always counted from the start, so that clamping to the end
+	// of a short month does not accumulate.
+	months := DifferenceInCalendarMonths(end, start)
+	for months > 0 && AddMonths(start, months).After(end) {
+		months--
+	}
+	cursor := AddMonths(start, months)
+
+	days := DifferenceInCalendarDays(end, cursor)
+	for days > 0 && AddDays(cursor, days).After(end) {
+		days--
+	}
+	cursor = AddDays(cursor, days)
+
+	rest := end.Sub(cursor)
+	p := Period{Years: months / 12, Months: months % 12, Days: days}
+	p.Hours = int(rest / time.Hour)
+	rest %= time.Hour
+	p.Minutes = int(rest / time.Minute)
+	rest %= time.Minute
+	p.Seconds = int(rest / time.Second)
+	p.Nanos = int(rest % time.Second)
+	return p
+}
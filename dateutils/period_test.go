@@ -0,0 +1,206 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Period
+		wantErr bool
+	}{
+		{input: "P1Y2M10DT2H30M", want: Period{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}},
+		{input: "P3W", want: Period{Weeks: 3}},
+		{input: "PT36H", want: Period{Hours: 36}},
+		{input: "P1M", want: Period{Months: 1}},
+		{input: "PT1M", want: Period{Minutes: 1}},
+		{input: "PT0.5S", want: Period{Nanos: 500000000}},
+		{input: "PT1,25S", want: Period{Seconds: 1, Nanos: 250000000}},
+		{input: "PT0.5H", want: Period{Seconds: 1800}},
+		{input: "PT1.5M", want: Period{Minutes: 1, Seconds: 30}},
+		{input: "P0D", want: Period{}},
+		{input: "-P1DT2H", want: Period{Days: -1, Hours: -2}},
+		{input: "P1D-2H", wantErr: true},
+		{input: "P1DT-2H", want: Period{Days: 1, Hours: -2}},
+		{input: "p1y2mt3h", want: Period{Years: 1, Months: 2, Hours: 3}},
+		{input: "P", wantErr: true},
+		{input: "PT", wantErr: true},
+		{input: "P1D2Y", wantErr: true},
+		{input: "P1.5Y", wantErr: true},
+		{input: "PT1.5H30M", wantErr: true},
+		{input: "P1H", wantErr: true},
+		{input: "1D", wantErr: true},
+		{input: "P1", wantErr: true},
+		{input: "PT1HT2M", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParsePeriod(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePeriod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePeriod() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParsePeriod(""); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("ParsePeriod(\"\") error = %v, want wrapped ErrEmptyInput", err)
+	}
+	var parseErr *ParseError
+	if _, err := ParsePeriod("P1D2Y"); !errors.As(err, &parseErr) || parseErr.Offset != 4 {
+		t.Errorf("ParsePeriod(\"P1D2Y\") error = %v, want *ParseError at offset 4", err)
+	}
+}
+
+func TestFormatISODuration(t *testing.T) {
+	tests := []struct {
+		name   string
+		period Period
+		want   string
+	}{
+		{name: "Zero", period: Period{}, want: "PT0S"},
+		{name: "All components", period: Period{Years: 1, Months: 2, Weeks: 1, Days: 10, Hours: 2, Minutes: 30, Seconds: 5},
+			want: "P1Y2M1W10DT2H30M5S"},
+		{name: "Weeks", period: Period{Weeks: 3}, want: "P3W"},
+		{name: "Time only", period: Period{Minutes: 90}, want: "PT90M"},
+		{name: "Fraction", period: Period{Seconds: 1, Nanos: 250000000}, want: "PT1.25S"},
+		{name: "Nanos only", period: Period{Nanos: 1}, want: "PT0.000000001S"},
+		{name: "Negative", period: Period{Hours: -1, Minutes: -30}, want: "-PT1H30M"},
+		{name: "Mixed signs", period: Period{Days: 1, Hours: -2}, want: "P1DT-2H"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatISODuration(tt.period)
+			if got != tt.want {
+				t.Errorf("FormatISODuration() = %q, want %q", got, tt.want)
+			}
+			if tt.period.String() != got {
+				t.Errorf("String() = %q, want %q", tt.period.String(), got)
+			}
+			parsed, err := ParsePeriod(got)
+			if err != nil || parsed != tt.period.carryNanos() {
+				t.Errorf("ParsePeriod(%q) = %+v, %v, want %+v", got, parsed, err, tt.period)
+			}
+		})
+	}
+}
+
+func TestPeriodNormalizeAndNegate(t *testing.T) {
+	got := Period{Months: 14, Minutes: 90, Seconds: 75, Nanos: 1500000000}.Normalize()
+	want := Period{Years: 1, Months: 2, Hours: 1, Minutes: 31, Seconds: 16, Nanos: 500000000}
+	if got != want {
+		t.Errorf("Normalize() = %+v, want %+v", got, want)
+	}
+
+	got = Period{Months: -13, Seconds: -61, Days: 40}.Normalize()
+	want = Period{Years: -1, Months: -1, Minutes: -1, Seconds: -1, Days: 40}
+	if got != want {
+		t.Errorf("Normalize() = %+v, want %+v", got, want)
+	}
+
+	p := Period{Years: 1, Weeks: -2, Nanos: 3}
+	if got := p.Negate(); got != (Period{Years: -1, Weeks: 2, Nanos: -3}) {
+		t.Errorf("Negate() = %+v", got)
+	}
+	if !(Period{}).IsZero() || p.IsZero() {
+		t.Error("IsZero() returned the wrong result")
+	}
+}
+
+func TestAddPeriod(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+
+	tests := []struct {
+		name   string
+		time   time.Time
+		period Period
+		want   time.Time
+	}{
+		{name: "End of month clamping", time: time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC), period: Period{Months: 1},
+			want: time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC)},
+		{name: "Years and months together", time: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), period: Period{Years: 1, Months: 1},
+			want: time.Date(2025, 3, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "Months before days", time: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), period: Period{Months: 1, Days: 1},
+			want: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Weeks", time: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), period: Period{Weeks: 2, Days: 1},
+			want: time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{name: "Time components", time: time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC), period: Period{Hours: 1, Minutes: 30, Seconds: 15, Nanos: 5},
+			want: time.Date(2024, 3, 2, 0, 30, 15, 5, time.UTC)},
+		{name: "Days across DST keep wall clock", time: time.Date(2024, 3, 9, 12, 0, 0, 0, newYork), period: Period{Days: 1},
+			want: time.Date(2024, 3, 10, 12, 0, 0, 0, newYork)},
+		{name: "Hours across DST are elapsed", time: time.Date(2024, 3, 9, 12, 0, 0, 0, newYork), period: Period{Hours: 24},
+			want: time.Date(2024, 3, 10, 13, 0, 0, 0, newYork)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AddPeriod(tt.time, tt.period); !got.Equal(tt.want) {
+				t.Errorf("AddPeriod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	start := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	if got := SubPeriod(start, Period{Months: 1, Days: 1}); !got.Equal(time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("SubPeriod() = %v, want 2024-02-28", got)
+	}
+}
+
+func TestIntervalToPeriod(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval Interval
+		want     Period
+	}{
+		{name: "date-fns example",
+			interval: Interval{Start: time.Date(1929, 1, 15, 12, 0, 0, 0, time.UTC), End: time.Date(1968, 4, 4, 19, 5, 0, 0, time.UTC)},
+			want:     Period{Years: 39, Months: 2, Days: 20, Hours: 7, Minutes: 5}},
+		{name: "Same instant", interval: Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			want: Period{}},
+		{name: "End of month start",
+			interval: Interval{Start: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 3, 30, 0, 0, 0, 0, time.UTC)},
+			want:     Period{Months: 1, Days: 30}},
+		{name: "Less than a month",
+			interval: Interval{Start: time.Date(2024, 1, 31, 22, 0, 0, 0, time.UTC), End: time.Date(2024, 2, 2, 1, 0, 0, 500, time.UTC)},
+			want:     Period{Days: 1, Hours: 3, Nanos: 500}},
+		{name: "Reversed interval",
+			interval: Interval{Start: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			want:     Period{Years: -1, Months: -2, Days: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IntervalToPeriod(tt.interval)
+			if got != tt.want {
+				t.Errorf("IntervalToPeriod() = %+v, want %+v", got, tt.want)
+			}
+			if tt.want.sign() > 0 || tt.want.IsZero() {
+				if end := AddPeriod(tt.interval.Start, got); !end.Equal(tt.interval.End) {
+					t.Errorf("AddPeriod(Start, %v) = %v, want End %v", got, end, tt.interval.End)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkParsePeriod(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParsePeriod("P1Y2M10DT2H30M15.5S")
+	}
+}
+
+func BenchmarkIntervalToPeriod(b *testing.B) {
+	interval := Interval{Start: time.Date(1929, 1, 15, 12, 0, 0, 0, time.UTC), End: time.Date(1968, 4, 4, 19, 5, 0, 0, time.UTC)}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = IntervalToPeriod(interval)
+	}
+}
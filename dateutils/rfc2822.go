@@ -0,0 +1,267 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// rfc2822Layout is the layout reported in ParseRFC2822 errors.
+const rfc2822Layout = time.RFC1123Z
+
+// rfc2822Zones are the obsolete zone names of RFC 5322 section 4.3, in hours.
+var rfc2822Zones = map[string]int{
+	"UT":  0,
+	"GMT": 0,
+	"EST": -5,
+	"EDT": -4,
+	"CST": -6,
+	"CDT": -5,
+	"MST": -7,
+	"MDT": -6,
+	"PST": -8,
+	"PDT": -7,
+}
+
+// ParseRFC2822 parses an Internet Message Format date, as in the Date
+// header of mail, following RFC 5322 (which obsoletes RFC 2822 and 822):
+// "Tue, 5 Mar 2024 14:05:07 -0300". The rules of the specification apply:
+//   - the day of the week is optional, but must match the date when present;
+//   - seconds are optional;
+//   - comments in parentheses, which may nest, and folding whitespace are
+//     allowed between the fields: "Tue, 5 Mar 2024 14:05 -0300 (BRT)";
+//   - two-digit years from 00 to 49 are read as 2000-2049 and from 50 to 99
+//     as 1950-1999, three-digit years are added to 1900, and years must be
+//     1900 or later;
+//   - the obsolete zones UT, GMT, EST, EDT, CST, CDT, MST, MDT, PST and PDT
+//     are accepted, and military zone letters are read as "-0000", as the
+//     RFC requires, since their meaning was historically reversed.
+//
+// Names are case-insensitive. The result keeps the offset of the input;
+// "-0000", which marks a UTC time whose local offset is unknown, and zero
+// offsets are returned in UTC.
+// Returns a *ParseError if the string is not a valid date, or an error
+// wrapping ErrEmptyInput for an empty string.
+//
+// Example:
+//
+//	ParseRFC2822("Tue, 5 Mar 2024 14:05:07 -0300")     // 2024-03-05 14:05:07 -0300
+//	ParseRFC2822("5 Mar 24 14:05 EST (Eastern)")       // 2024-03-05 14:05:00 EST
+func ParseRFC2822(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, fmt.Errorf("cannot parse date string: %w", ErrEmptyInput)
+	}
+	text, err := blankComments(s)
+	if err != nil {
+		return time.Time{}, newParseError(s, []LayoutAttempt{{Layout: rfc2822Layout, Offset: len(s), Err: err}})
+	}
+
+	in := &tokenInput{s: text}
+	fail := func(reason string) (time.Time, error) {
+		return time.Time{}, newParseError(s, []LayoutAttempt{{Layout: rfc2822Layout, Offset: in.pos, Err: errors.New(reason)}})
+	}
+	space := func() bool {
+		start := in.pos
+		in.skipSpaces()
+		return in.pos > start
+	}
+
+	space()
+	weekday := -1
+	if index, ok := in.name(LocaleEnUS.WeekdaysAbbreviated[:]); ok {
+		weekday = index
+		space()
+		if !in.literal(",") {
+			return fail(`expected "," after the day of the week`)
+		}
+		space()
+	}
+
+	day, ok := in.digits(1, 2)
+	if !ok {
+		return fail("expected the day of the month")
+	}
+	if !space() {
+		return fail("expected a space after the day")
+	}
+	month, ok := in.name(LocaleEnUS.MonthsAbbreviated[:])
+	if !ok {
+		return fail("expected a month name such as Jan")
+	}
+	if !space() {
+		return fail("expected a space after the month")
+	}
+	yearStart := in.pos
+	year, ok := in.digits(2, 9)
+	if !ok {
+		return fail("expected the year")
+	}
+	switch digits := in.pos - yearStart; {
+	case digits == 2 && year < 50:
+		year += 2000
+	case digits <= 3:
+		year += 1900
+	}
+	if year < 1900 {
+		in.pos = yearStart
+		return fail("expected a year of 1900 or later")
+	}
+	if !space() {
+		return fail("expected a space after the year")
+	}
+
+	hour, minute, second, reason := readRFCClock(in, true)
+	if reason != "" {
+		return fail(reason)
+	}
+	if !space() {
+		return fail("expected a space before the zone")
+	}
+	location, reason := readRFC2822Zone(in)
+	if reason != "" {
+		return fail(reason)
+	}
+	space()
+	if in.pos < len(in.s) {
+		return fail("unexpected text after the zone")
+	}
+
+	t, reason := rfcDate(year, month+1, day, hour, minute, second, weekday, location)
+	if reason != "" {
+		in.pos = 0
+		return fail(reason)
+	}
+	return t, nil
+}
+
+// FormatRFC2822 formats a time as an RFC 5322 date, with the day of the
+// week, seconds and a numeric offset: "Tue, 05 Mar 2024 14:05:07 -0300".
+// Returns an error wrapping ErrZeroTime for the zero time, or an error if
+// the year is before 1900 or after 9999, which the RFC cannot express.
+//
+// Example:
+//
+//	FormatRFC2822(time.Date(2024, 3, 5, 14, 5, 7, 0, time.UTC)) // "Tue, 05 Mar 2024 14:05:07 +0000"
+func FormatRFC2822(t time.Time) (string, error) {
+	if t.IsZero() {
+		return "", fmt.Errorf("cannot format: %w", ErrZeroTime)
+	}
+	if t.Year() < 1900 || t.Year() > 9999 {
+		return "", fmt.Errorf("cannot format year %d as an RFC 2822 date", t.Year())
+	}
+	return t.Format(time.RFC1123Z), nil
+}
+
+// blankComments replaces the RFC 5322 comments of s, including nested
+// comments and quoted pairs, with spaces, so offsets stay those of s.
+func blankComments(s string) (string, error) {
+	if strings.IndexByte(s, '(') < 0 {
+		return s, nil
+	}
+	b := []byte(s)
+	depth := 0
+	for i := 0; i < len(b); i++ {
+		switch {
+		case b[i] == '(':
+			depth++
+		case b[i] == ')' && depth > 0:
+			depth--
+		case b[i] == '\\' && depth > 0 && i+1 < len(b):
+			b[i] = ' '
+			i++
+		case depth == 0:
+			continue
+		}
+		b[i] = ' '
+	}
+	if depth > 0 {
+		return "", errors.New("unterminated comment")
+	}
+	return string(b), nil
+}
+
+// readRFCClock reads hh:mm:ss, where the seconds are optional if
+// optionalSeconds is set, and returns a reason when it fails.
+func readRFCClock(in *tokenInput, optionalSeconds bool) (hour, minute, second int, reason string) {
+	var ok bool
+	if hour, ok = in.digits(2, 2); !ok || hour > 23 {
+		return 0, 0, 0, "expected the hour (00-23)"
+	}
+	if !in.literal(":") {
+		return 0, 0, 0, `expected ":" after the hour`
+	}
+	if minute, ok = in.digits(2, 2); !ok || minute > 59 {
+		return 0, 0, 0, "expected the minute (00-59)"
+	}
+	if !in.literal(":") {
+		if optionalSeconds {
+			return hour, minute, 0, ""
+		}
+		return 0, 0, 0, `expected ":" after the minute`
+	}
+	// A second of 60 is a leap second.
+	if second, ok = in.digits(2, 2); !ok || second > 60 {
+		return 0, 0, 0, "expected the second (00-60)"
+	}
+	return hour, minute, second, ""
+}
+
+// readRFC2822Zone reads a numeric offset or an obsolete zone name.
+func readRFC2822Zone(in *tokenInput) (*time.Location, string) {
+	if in.pos < len(in.s) && (in.s[in.pos] == '+' || in.s[in.pos] == '-') {
+		negative := in.s[in.pos] == '-'
+		in.pos++
+		value, ok := in.digits(4, 4)
+		if !ok || value%100 > 59 {
+			return nil, `expected an offset such as "-0300"`
+		}
+		offset := (value/100*60 + value%100) * 60
+		if negative {
+			offset = -offset
+		}
+		if offset == 0 {
+			return time.UTC, ""
+		}
+		return time.FixedZone("", offset), ""
+	}
+
+	start := in.pos
+	for in.pos < len(in.s) && isASCIILetter(in.s[in.pos]) {
+		in.pos++
+	}
+	name := strings.ToUpper(in.s[start:in.pos])
+	if hours, ok := rfc2822Zones[name]; ok {
+		if hours == 0 {
+			return time.UTC, ""
+		}
+		return time.FixedZone(name, hours*3600), ""
+	}
+	if len(name) == 1 && name != "J" {
+		return time.UTC, ""
+	}
+	in.pos = start
+	return nil, `expected a zone such as "-0300" or "GMT"`
+}
+
+// rfcDate builds a date after checking the day of the month and, if
+// weekday is not -1, the day of the week.
+func rfcDate(year, month, day, hour, minute, second, weekday int, location *time.Location) (time.Time, string) {
+	if day < 1 || day > GetDaysInMonth(time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)) {
+		return time.Time{}, fmt.Sprintf("day %d out of range for %s %d", day, time.Month(month), year)
+	}
+	t := time.Date(year, time.Month(month), day, hour, minute, second, 0, location)
+	if weekday >= 0 && int(t.Weekday()) != weekday {
+		return time.Time{}, fmt.Sprintf("%s is a %s, not a %s", t.Format("2 Jan 2006"), t.Weekday(), time.Weekday(weekday))
+	}
+	return t, ""
+}
+
+// literal consumes s if the input continues with it, case-sensitively.
+func (in *tokenInput) literal(s string) bool {
+	if !strings.HasPrefix(in.rest(), s) {
+		return false
+	}
+	in.pos += len(s)
+	return true
+}
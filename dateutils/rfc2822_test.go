@@ -0,0 +1,117 @@
+package dateutils
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRFC2822(t *testing.T) {
+	brt := time.FixedZone("", -3*3600)
+	est := time.FixedZone("EST", -5*3600)
+
+	tests := []struct {
+		name       string
+		input      string
+		want       time.Time
+		wantOffset int
+	}{
+		{"Full date", "Tue, 05 Mar 2024 14:05:07 -0300", time.Date(2024, 3, 5, 14, 5, 7, 0, brt), -3 * 3600},
+		{"No day of the week", "5 Mar 2024 14:05:07 +0530", time.Date(2024, 3, 5, 14, 5, 7, 0, time.FixedZone("", 19800)), 19800},
+		{"No seconds", "Tue, 5 Mar 2024 14:05 -0300", time.Date(2024, 3, 5, 14, 5, 0, 0, brt), -3 * 3600},
+		{"Comments", "Tue, 5 Mar 2024 14:05:07 -0300 (BRT (Brasilia \\) time))", time.Date(2024, 3, 5, 14, 5, 7, 0, brt), -3 * 3600},
+		{"Comment between fields", "Tue,(weekday)5 Mar 2024 14:05:07 -0300", time.Date(2024, 3, 5, 14, 5, 7, 0, brt), -3 * 3600},
+		{"Folding whitespace", "Tue,\r\n 5  Mar\t2024 14:05:07 -0300", time.Date(2024, 3, 5, 14, 5, 7, 0, brt), -3 * 3600},
+		{"Case-insensitive names", "tue, 5 MAR 2024 14:05:07 gmt", time.Date(2024, 3, 5, 14, 5, 7, 0, time.UTC), 0},
+		{"Two-digit year before 50", "5 Mar 24 14:05 EST", time.Date(2024, 3, 5, 14, 5, 0, 0, est), -5 * 3600},
+		{"Two-digit year from 50", "Sun, 6 Nov 94 08:49:37 GMT", time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC), 0},
+		{"Three-digit year", "6 Nov 094 08:49:37 UT", time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC), 0},
+		{"Obsolete zone", "Tue, 5 Mar 2024 14:05:07 PDT", time.Date(2024, 3, 5, 14, 5, 7, 0, time.FixedZone("PDT", -7*3600)), -7 * 3600},
+		{"Military zone", "Tue, 5 Mar 2024 14:05:07 Z", time.Date(2024, 3, 5, 14, 5, 7, 0, time.UTC), 0},
+		{"Unknown local offset", "Tue, 5 Mar 2024 14:05:07 -0000", time.Date(2024, 3, 5, 14, 5, 7, 0, time.UTC), 0},
+		{"Leap second", "31 Dec 2016 23:59:60 +0000", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRFC2822(tt.input)
+			if err != nil {
+				t.Fatalf("ParseRFC2822(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseRFC2822(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+			if _, offset := got.Zone(); offset != tt.wantOffset {
+				t.Errorf("ParseRFC2822(%q) offset = %d, want %d", tt.input, offset, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestParseRFC2822Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		reason string
+	}{
+		{"Wrong day of the week", "Mon, 5 Mar 2024 14:05:07 -0300", "is a Tuesday, not a Monday"},
+		{"Missing comma", "Tue 5 Mar 2024 14:05:07 -0300", `expected ","`},
+		{"Day out of range", "30 Feb 2024 14:05:07 -0300", "day 30 out of range"},
+		{"Hour out of range", "5 Mar 2024 24:05:07 -0300", "hour"},
+		{"Second out of range", "5 Mar 2024 14:05:61 -0300", "second"},
+		{"Year before 1900", "5 Mar 1899 14:05:07 -0300", "1900 or later"},
+		{"Missing zone", "5 Mar 2024 14:05:07", "expected a space before the zone"},
+		{"Unknown zone", "5 Mar 2024 14:05:07 BRT", "expected a zone"},
+		{"Military J", "5 Mar 2024 14:05:07 J", "expected a zone"},
+		{"Offset minutes", "5 Mar 2024 14:05:07 -0375", "expected an offset"},
+		{"Unterminated comment", "5 Mar 2024 14:05:07 -0300 (BRT", "unterminated comment"},
+		{"Trailing text", "5 Mar 2024 14:05:07 -0300 BRT", "unexpected text"},
+		{"Full month name", "5 March 2024 14:05:07 -0300", "expected a space after the month"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRFC2822(tt.input)
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ParseRFC2822(%q) error = %v, want a *ParseError", tt.input, err)
+			}
+			if !strings.Contains(err.Error(), tt.reason) {
+				t.Errorf("ParseRFC2822(%q) error = %v, want it to mention %q", tt.input, err, tt.reason)
+			}
+		})
+	}
+
+	if _, err := ParseRFC2822(""); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("ParseRFC2822(\"\") error = %v, want ErrEmptyInput", err)
+	}
+}
+
+func TestFormatRFC2822(t *testing.T) {
+	date := time.Date(2024, 3, 5, 14, 5, 7, 0, time.FixedZone("BRT", -3*3600))
+	got, err := FormatRFC2822(date)
+	if err != nil {
+		t.Fatalf("FormatRFC2822() error = %v", err)
+	}
+	if want := "Tue, 05 Mar 2024 14:05:07 -0300"; got != want {
+		t.Errorf("FormatRFC2822() = %q, want %q", got, want)
+	}
+	back, err := ParseRFC2822(got)
+	if err != nil || !back.Equal(date) {
+		t.Errorf("ParseRFC2822(%q) = %v, %v, want %v", got, back, err, date)
+	}
+
+	if _, err := FormatRFC2822(time.Time{}); !errors.Is(err, ErrZeroTime) {
+		t.Errorf("FormatRFC2822(zero) error = %v, want ErrZeroTime", err)
+	}
+	if _, err := FormatRFC2822(time.Date(1850, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("FormatRFC2822(1850) error = nil, want an error")
+	}
+}
+
+func BenchmarkParseRFC2822(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseRFC2822("Tue, 5 Mar 2024 14:05:07 -0300 (BRT)")
+	}
+}
@@ -0,0 +1,605 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxSpelledNumber is the largest number the built-in rules spell out.
+const maxSpelledNumber = 999_999
+
+// FormatOrdinal writes n as an ordinal number with the locale's rule:
+// "21st" in English, "21º" or "3ª" in Portuguese, "1er" in French and
+// "21." in German. The unit picks the grammatical gender where the language
+// needs it. A nil locale means LocaleEnUS.
+//
+// Example:
+//
+//	FormatOrdinal(21, OrdinalDate, nil)         // "21st"
+//	FormatOrdinal(2, OrdinalWeek, LocalePtBR)   // "2ª"
+//	FormatOrdinal(1, OrdinalNumber, LocaleFR)   // "1er"
+func FormatOrdinal(n int, unit OrdinalUnit, locale *Locale) string {
+	if locale == nil {
+		locale = LocaleEnUS
+	}
+	return locale.ordinal(n, unit)
+}
+
+// SpellNumber writes n in words with the locale's SpellOut rule, such as
+// "twenty-one" or "vinte e uma" for a feminine unit. The built-in rules
+// cover 0 to 999,999. A nil locale means LocaleEnUS.
+// Returns an error wrapping errors.ErrUnsupported if the locale cannot spell
+// out n, as LocalePL and LocaleRU cannot.
+//
+// Example:
+//
+//	SpellNumber(2024, OrdinalYear, nil)        // "two thousand twenty-four"
+//	SpellNumber(2024, OrdinalYear, LocalePtBR) // "dois mil e vinte e quatro"
+func SpellNumber(n int, unit OrdinalUnit, locale *Locale) (string, error) {
+	return spellNumber(n, unit, false, locale)
+}
+
+// SpellOrdinal writes n as an ordinal in words, such as "twenty-first" or
+// "vigésima primeira" for a feminine unit. Otherwise it works like SpellNumber.
+//
+// Example:
+//
+//	SpellOrdinal(21, OrdinalDate, nil)      // "twenty-first"
+//	SpellOrdinal(21, OrdinalDate, LocaleDE) // "einundzwanzigste"
+func SpellOrdinal(n int, unit OrdinalUnit, locale *Locale) (string, error) {
+	return spellNumber(n, unit, true, locale)
+}
+
+// spellNumber backs SpellNumber and SpellOrdinal.
+func spellNumber(n int, unit OrdinalUnit, ordinal bool, locale *Locale) (string, error) {
+	if locale == nil {
+		locale = LocaleEnUS
+	}
+	words := locale.spell(n, unit, ordinal)
+	if words == "" {
+		return "", fmt.Errorf("locale %q cannot spell out %d: %w", locale.Code, n, errors.ErrUnsupported)
+	}
+	return words, nil
+}
+
+// FormatSpelledOut writes a date in words with the locale's SpelledDateFormat,
+// as used in legal documents. A nil locale means LocaleEnUS.
+// Returns an error wrapping ErrZeroTime for the zero time, or
+// errors.ErrUnsupported if the locale has no spelled-out date format.
+//
+// Example:
+//
+//	date := time.Date(2024, time.March, 21, 0, 0, 0, 0, time.UTC)
+//	FormatSpelledOut(date, nil)        // "the twenty-first day of March, two thousand twenty-four"
+//	FormatSpelledOut(date, LocalePtBR) // "vinte e um de março de dois mil e vinte e quatro"
+func FormatSpelledOut(t time.Time, locale *Locale) (string, error) {
+	if locale == nil {
+		locale = LocaleEnUS
+	}
+	if locale.SpellOut == nil || locale.SpelledDateFormat == "" {
+		return "", fmt.Errorf("locale %q cannot spell out dates: %w", locale.Code, errors.ErrUnsupported)
+	}
+	return FormatLocale(t, locale.SpelledDateFormat, locale)
+}
+
+// spell writes n with the Locale's SpellOut rule, or returns "" if it cannot.
+func (l *Locale) spell(n int, unit OrdinalUnit, ordinal bool) string {
+	if l.SpellOut == nil || n < 0 || n > maxSpelledNumber || (ordinal && n == 0) {
+		return ""
+	}
+	return l.SpellOut(n, unit, ordinal)
+}
+
+// replaceLastWord applies f to the last word of words, which ends at a
+// space or hyphen.
+func replaceLastWord(words string, f func(word string) string) string {
+	i := strings.LastIndexAny(words, " -") + 1
+	return words[:i] + f(words[i:])
+}
+
+// feminineWords turns the masculine "-o" endings of every word into "-a".
+func feminineWords(words string) string {
+	fields := strings.Split(words, " ")
+	for i, word := range fields {
+		if strings.HasSuffix(word, "o") {
+			fields[i] = strings.TrimSuffix(word, "o") + "a"
+		}
+	}
+	return strings.Join(fields, " ")
+}
+
+// isFeminineUnit reports whether Portuguese, Spanish and Italian count the
+// unit with feminine forms (semana, hora / settimana, ora).
+func isFeminineUnit(unit OrdinalUnit) bool {
+	return unit == OrdinalWeek || unit == OrdinalHour
+}
+
+var (
+	englishOnes = [20]string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	englishTens = [10]string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+
+	englishOrdinalWords = map[string]string{
+		"one": "first", "two": "second", "three": "third", "five": "fifth",
+		"eight": "eighth", "nine": "ninth", "twelve": "twelfth",
+	}
+)
+
+// spellEnglish is the American English SpellOut rule: "two thousand twenty-four".
+func spellEnglish(n int, _ OrdinalUnit, ordinal bool) string {
+	return englishWords(n, ordinal, false)
+}
+
+// spellBritish is the British English SpellOut rule: "two thousand and twenty-four".
+func spellBritish(n int, _ OrdinalUnit, ordinal bool) string {
+	return englishWords(n, ordinal, true)
+}
+
+// englishWords spells n, with "and" before the last two digits when british.
+func englishWords(n int, ordinal, british bool) string {
+	words := englishCardinal(n, british)
+	if !ordinal {
+		return words
+	}
+	return replaceLastWord(words, func(word string) string {
+		if irregular, ok := englishOrdinalWords[word]; ok {
+			return irregular
+		}
+		if strings.HasSuffix(word, "y") {
+			return strings.TrimSuffix(word, "y") + "ieth"
+		}
+		return word + "th"
+	})
+}
+
+// englishCardinal spells n in English.
+func englishCardinal(n int, british bool) string {
+	join := func(head string, rest int) string {
+		switch {
+		case rest == 0:
+			return head
+		case british && rest < 100:
+			return head + " and " + englishCardinal(rest, british)
+		default:
+			return head + " " + englishCardinal(rest, british)
+		}
+	}
+	switch {
+	case n < 20:
+		return englishOnes[n]
+	case n < 100:
+		if n%10 == 0 {
+			return englishTens[n/10]
+		}
+		return englishTens[n/10] + "-" + englishOnes[n%10]
+	case n < 1000:
+		return join(englishOnes[n/100]+" hundred", n%100)
+	default:
+		return join(englishCardinal(n/1000, british)+" thousand", n%1000)
+	}
+}
+
+var (
+	portugueseOnes = [20]string{"zero", "um", "dois", "três", "quatro", "cinco", "seis", "sete", "oito", "nove",
+		"dez", "onze", "doze", "treze", "catorze", "quinze", "dezesseis", "dezessete", "dezoito", "dezenove"}
+	portugueseTens     = [10]string{"", "", "vinte", "trinta", "quarenta", "cinquenta", "sessenta", "setenta", "oitenta", "noventa"}
+	portugueseHundreds = [10]string{"", "cento", "duzentos", "trezentos", "quatrocentos", "quinhentos",
+		"seiscentos", "setecentos", "oitocentos", "novecentos"}
+
+	portugueseOrdinalOnes = [10]string{"", "primeiro", "segundo", "terceiro", "quarto", "quinto",
+		"sexto", "sétimo", "oitavo", "nono"}
+	portugueseOrdinalTens = [10]string{"", "décimo", "vigésimo", "trigésimo", "quadragésimo", "quinquagésimo",
+		"sexagésimo", "septuagésimo", "octogésimo", "nonagésimo"}
+	portugueseOrdinalHundreds = [10]string{"", "centésimo", "ducentésimo", "trecentésimo", "quadringentésimo",
+		"quingentésimo", "sexcentésimo", "septingentésimo", "octingentésimo", "nongentésimo"}
+)
+
+// spellPortuguese is the Brazilian Portuguese SpellOut rule, which calls the
+// first day of the month "primeiro".
+func spellPortuguese(n int, unit OrdinalUnit, ordinal bool) string {
+	if n == 1 && unit == OrdinalDate && !ordinal {
+		return "primeiro"
+	}
+	return portugueseWords(n, unit, ordinal, false)
+}
+
+// spellEuropeanPortuguese is the European Portuguese SpellOut rule
+// ("dezasseis", "dezanove").
+func spellEuropeanPortuguese(n int, unit OrdinalUnit, ordinal bool) string {
+	return portugueseWords(n, unit, ordinal, true)
+}
+
+// portugueseWords spells n in Portuguese.
+func portugueseWords(n int, unit OrdinalUnit, ordinal, european bool) string {
+	feminine := isFeminineUnit(unit)
+	if !ordinal {
+		return portugueseCardinal(n, feminine, european)
+	}
+
+	var parts []string
+	if thousands := n / 1000; thousands > 0 {
+		if thousands > 1 {
+			parts = append(parts, portugueseCardinal(thousands, false, european))
+		}
+		parts = append(parts, "milésimo")
+	}
+	for _, part := range []string{portugueseOrdinalHundreds[n/100%10], portugueseOrdinalTens[n/10%10], portugueseOrdinalOnes[n%10]} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	words := strings.Join(parts, " ")
+	if feminine {
+		return feminineWords(words)
+	}
+	return words
+}
+
+// portugueseCardinal spells n in Portuguese, joining the parts with "e".
+func portugueseCardinal(n int, feminine, european bool) string {
+	switch {
+	case n < 20:
+		switch {
+		case feminine && n == 1:
+			return "uma"
+		case feminine && n == 2:
+			return "duas"
+		case european && n == 16:
+			return "dezasseis"
+		case european && n == 17:
+			return "dezassete"
+		case european && n == 19:
+			return "dezanove"
+		}
+		return portugueseOnes[n]
+	case n < 100:
+		if n%10 == 0 {
+			return portugueseTens[n/10]
+		}
+		return portugueseTens[n/10] + " e " + portugueseCardinal(n%10, feminine, european)
+	case n < 1000:
+		if n == 100 {
+			return "cem"
+		}
+		hundreds := portugueseHundreds[n/100]
+		if feminine && n >= 200 {
+			hundreds = strings.TrimSuffix(hundreds, "os") + "as"
+		}
+		if n%100 == 0 {
+			return hundreds
+		}
+		return hundreds + " e " + portugueseCardinal(n%100, feminine, european)
+	default:
+		words := "mil"
+		if n/1000 > 1 {
+			words = portugueseCardinal(n/1000, feminine, european) + " mil"
+		}
+		rest := n % 1000
+		switch {
+		case rest == 0:
+			return words
+		case rest < 100 || rest%100 == 0:
+			return words + " e " + portugueseCardinal(rest, feminine, european)
+		default:
+			return words + " " + portugueseCardinal(rest, feminine, european)
+		}
+	}
+}
+
+var (
+	spanishOnes = [30]string{"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve",
+		"diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve",
+		"veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis",
+		"veintisiete", "veintiocho", "veintinueve"}
+	spanishTens     = [10]string{"", "", "veinte", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+	spanishHundreds = [10]string{"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos",
+		"seiscientos", "setecientos", "ochocientos", "novecientos"}
+
+	spanishOrdinalOnes = [10]string{"", "primero", "segundo", "tercero", "cuarto", "quinto",
+		"sexto", "séptimo", "octavo", "noveno"}
+	spanishOrdinalTens = [10]string{"", "décimo", "vigésimo", "trigésimo", "cuadragésimo", "quincuagésimo",
+		"sexagésimo", "septuagésimo", "octogésimo", "nonagésimo"}
+	spanishOrdinalHundreds = [10]string{"", "centésimo", "ducentésimo", "tricentésimo", "cuadringentésimo",
+		"quingentésimo", "sexcentésimo", "septingentésimo", "octingentésimo", "noningentésimo"}
+)
+
+// spellSpanish is the Spanish SpellOut rule.
+func spellSpanish(n int, unit OrdinalUnit, ordinal bool) string {
+	if !ordinal {
+		return spanishCardinal(n)
+	}
+
+	var parts []string
+	if thousands := n / 1000; thousands > 0 {
+		if thousands > 1 {
+			parts = append(parts, spanishApocope(spanishCardinal(thousands)))
+		}
+		parts = append(parts, "milésimo")
+	}
+	if hundreds := spanishOrdinalHundreds[n/100%10]; hundreds != "" {
+		parts = append(parts, hundreds)
+	}
+	switch rest := n % 100; {
+	case rest == 11:
+		parts = append(parts, "undécimo")
+	case rest == 12:
+		parts = append(parts, "duodécimo")
+	case rest > 12 && rest < 20:
+		parts = append(parts, strings.Replace("decimo"+spanishOrdinalOnes[rest%10], "oo", "o", 1))
+	default:
+		for _, part := range []string{spanishOrdinalTens[rest/10], spanishOrdinalOnes[rest%10]} {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+	}
+	words := strings.Join(parts, " ")
+	if isFeminineUnit(unit) {
+		return feminineWords(words)
+	}
+	return words
+}
+
+// spanishCardinal spells n in Spanish.
+func spanishCardinal(n int) string {
+	switch {
+	case n < 30:
+		return spanishOnes[n]
+	case n < 100:
+		if n%10 == 0 {
+			return spanishTens[n/10]
+		}
+		return spanishTens[n/10] + " y " + spanishOnes[n%10]
+	case n < 1000:
+		if n == 100 {
+			return "cien"
+		}
+		if n%100 == 0 {
+			return spanishHundreds[n/100]
+		}
+		return spanishHundreds[n/100] + " " + spanishCardinal(n%100)
+	default:
+		words := "mil"
+		if n/1000 > 1 {
+			words = spanishApocope(spanishCardinal(n/1000)) + " mil"
+		}
+		if n%1000 == 0 {
+			return words
+		}
+		return words + " " + spanishCardinal(n%1000)
+	}
+}
+
+// spanishApocope shortens a final "uno" before a noun: veintiún mil,
+// treinta y un mil.
+func spanishApocope(words string) string {
+	switch {
+	case strings.HasSuffix(words, "veintiuno"):
+		return strings.TrimSuffix(words, "uno") + "ún"
+	case strings.HasSuffix(words, "uno"):
+		return strings.TrimSuffix(words, "o")
+	}
+	return words
+}
+
+var (
+	frenchOnes = [17]string{"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf",
+		"dix", "onze", "douze", "treize", "quatorze", "quinze", "seize"}
+	frenchTens = [10]string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante", "soixante", "quatre-vingt", "quatre-vingt"}
+)
+
+// spellFrench is the French SpellOut rule, which calls the first day of the
+// month "premier".
+func spellFrench(n int, unit OrdinalUnit, ordinal bool) string {
+	if n == 1 && (ordinal || unit == OrdinalDate) {
+		if ordinal && isFeminineUnit(unit) {
+			return "première"
+		}
+		return "premier"
+	}
+	words := frenchCardinal(n)
+	if !ordinal {
+		return words
+	}
+	return replaceLastWord(words, func(word string) string {
+		switch {
+		case word == "vingts" || word == "cents": // quatre-vingts, deux cents
+			return strings.TrimSuffix(word, "s") + "ième"
+		case word == "cinq":
+			return "cinquième"
+		case word == "neuf":
+			return "neuvième"
+		case strings.HasSuffix(word, "e"):
+			return strings.TrimSuffix(word, "e") + "ième"
+		default:
+			return word + "ième"
+		}
+	})
+}
+
+// frenchCardinal spells n in French, with the traditional hyphens below 100.
+func frenchCardinal(n int) string {
+	switch {
+	case n < 17:
+		return frenchOnes[n]
+	case n < 20:
+		return "dix-" + frenchOnes[n-10]
+	case n < 100:
+		tens, ones := n/10, n%10
+		if tens == 7 || tens == 9 {
+			// soixante-dix, quatre-vingt-dix: the tens go on counting from ten
+			ones += 10
+		}
+		switch {
+		case ones == 0 && tens == 8:
+			return "quatre-vingts"
+		case ones == 0:
+			return frenchTens[tens]
+		case (ones == 1 || ones == 11) && tens != 8 && tens != 9:
+			return frenchTens[tens] + " et " + frenchCardinal(ones)
+		default:
+			return frenchTens[tens] + "-" + frenchCardinal(ones)
+		}
+	case n < 1000:
+		words := "cent"
+		if n/100 > 1 {
+			words = frenchOnes[n/100] + " cent"
+			if n%100 == 0 {
+				return words + "s"
+			}
+		}
+		if n%100 == 0 {
+			return words
+		}
+		return words + " " + frenchCardinal(n%100)
+	default:
+		words := "mille"
+		if n/1000 > 1 {
+			// "vingts" and "cents" lose their s before mille
+			words = strings.TrimSuffix(frenchCardinal(n/1000), "s") + " mille"
+		}
+		if n%1000 == 0 {
+			return words
+		}
+		return words + " " + frenchCardinal(n%1000)
+	}
+}
+
+var (
+	germanOnes = [20]string{"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun",
+		"zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn"}
+	germanTens = [10]string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+)
+
+// spellGerman is the German SpellOut rule, which writes numbers as one word.
+func spellGerman(n int, _ OrdinalUnit, ordinal bool) string {
+	words := germanCardinal(n)
+	if !ordinal {
+		return words
+	}
+	switch rest := n % 100; {
+	case rest == 0 || rest >= 20:
+		return words + "ste"
+	case rest == 1:
+		return strings.TrimSuffix(words, "eins") + "erste"
+	case rest == 3:
+		return strings.TrimSuffix(words, "drei") + "dritte"
+	case rest == 7:
+		return strings.TrimSuffix(words, "sieben") + "siebte"
+	case rest == 8:
+		return words + "e"
+	default:
+		return words + "te"
+	}
+}
+
+// germanCardinal spells n in German.
+func germanCardinal(n int) string {
+	// prefix is n as the first part of a compound: "ein" rather than "eins".
+	prefix := func(n int) string {
+		return strings.TrimSuffix(germanCardinal(n), "s")
+	}
+	rest := func(n int) string {
+		if n == 0 {
+			return ""
+		}
+		return germanCardinal(n)
+	}
+	switch {
+	case n < 20:
+		return germanOnes[n]
+	case n < 100:
+		if n%10 == 0 {
+			return germanTens[n/10]
+		}
+		return prefix(n%10) + "und" + germanTens[n/10]
+	case n < 1000:
+		return prefix(n/100) + "hundert" + rest(n%100)
+	default:
+		return prefix(n/1000) + "tausend" + rest(n%1000)
+	}
+}
+
+var (
+	italianOnes = [20]string{"zero", "uno", "due", "tre", "quattro", "cinque", "sei", "sette", "otto", "nove",
+		"dieci", "undici", "dodici", "tredici", "quattordici", "quindici", "sedici", "diciassette", "diciotto", "diciannove"}
+	italianTens = [10]string{"", "", "venti", "trenta", "quaranta", "cinquanta", "sessanta", "settanta", "ottanta", "novanta"}
+
+	italianOrdinals = [11]string{"", "primo", "secondo", "terzo", "quarto", "quinto",
+		"sesto", "settimo", "ottavo", "nono", "decimo"}
+)
+
+// spellItalian is the Italian SpellOut rule, which writes numbers as one
+// word and calls the first day of the month "primo".
+func spellItalian(n int, unit OrdinalUnit, ordinal bool) string {
+	var words string
+	switch {
+	case n == 1 && unit == OrdinalDate && !ordinal:
+		return "primo"
+	case !ordinal:
+		return italianCardinal(n)
+	case n <= 10:
+		words = italianOrdinals[n]
+	default:
+		words = italianCardinal(n)
+		switch {
+		case strings.HasSuffix(words, "tré"):
+			words = strings.TrimSuffix(words, "tré") + "treesimo"
+		case strings.HasSuffix(words, "sei"):
+			words += "esimo"
+		case strings.HasSuffix(words, "mila"):
+			words = strings.TrimSuffix(words, "mila") + "millesimo"
+		default:
+			words = words[:len(words)-1] + "esimo"
+		}
+	}
+	if isFeminineUnit(unit) {
+		return feminineWords(words)
+	}
+	return words
+}
+
+// italianCardinal spells n in Italian.
+func italianCardinal(n int) string {
+	switch {
+	case n < 20:
+		return italianOnes[n]
+	case n < 100:
+		tens, ones := italianTens[n/10], n%10
+		switch ones {
+		case 0:
+			return tens
+		case 1, 8:
+			// The tens lose their vowel before uno and otto: ventuno, ventotto
+			return tens[:len(tens)-1] + italianOnes[ones]
+		case 3:
+			return tens + "tré"
+		default:
+			return tens + italianOnes[ones]
+		}
+	case n < 1000:
+		words := "cento"
+		if n/100 > 1 {
+			words = italianOnes[n/100] + "cento"
+		}
+		if n%100 == 0 {
+			return words
+		}
+		rest := italianCardinal(n % 100)
+		if strings.HasPrefix(rest, "ott") {
+			words = strings.TrimSuffix(words, "o")
+		}
+		return words + rest
+	default:
+		words := "mille"
+		if n/1000 > 1 {
+			words = italianCardinal(n/1000) + "mila"
+		}
+		if n%1000 == 0 {
+			return words
+		}
+		return words + italianCardinal(n%1000)
+	}
+}
@@ -0,0 +1,195 @@
+package dateutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFormatOrdinal(t *testing.T) {
+	tests := []struct {
+		n        int
+		unit     OrdinalUnit
+		locale   *Locale
+		expected string
+	}{
+		{21, OrdinalDate, nil, "21st"},
+		{12, OrdinalDate, LocaleEnGB, "12th"},
+		{1, OrdinalNumber, LocalePtBR, "1º"},
+		{2, OrdinalWeek, LocalePtBR, "2ª"},
+		{1, OrdinalNumber, LocaleFR, "1er"},
+		{1, OrdinalWeek, LocaleFR, "1re"},
+		{3, OrdinalNumber, LocaleFR, "3e"},
+		{1, OrdinalDate, LocaleDE, "1."},
+	}
+
+	for _, tt := range tests {
+		if got := FormatOrdinal(tt.n, tt.unit, tt.locale); got != tt.expected {
+			t.Errorf("FormatOrdinal(%d, %v, %v) = %q, want %q", tt.n, tt.unit, tt.locale, got, tt.expected)
+		}
+	}
+}
+
+func TestSpellNumber(t *testing.T) {
+	tests := []struct {
+		n        int
+		unit     OrdinalUnit
+		locale   *Locale
+		cardinal string
+		ordinal  string
+	}{
+		{0, OrdinalNumber, LocaleEnUS, "zero", ""},
+		{12, OrdinalNumber, LocaleEnUS, "twelve", "twelfth"},
+		{21, OrdinalDate, LocaleEnUS, "twenty-one", "twenty-first"},
+		{40, OrdinalNumber, LocaleEnUS, "forty", "fortieth"},
+		{2024, OrdinalYear, LocaleEnUS, "two thousand twenty-four", "two thousand twenty-fourth"},
+		{101, OrdinalNumber, LocaleEnGB, "one hundred and one", "one hundred and first"},
+		{2024, OrdinalYear, LocaleEnGB, "two thousand and twenty-four", "two thousand and twenty-fourth"},
+
+		{1, OrdinalDate, LocalePtBR, "primeiro", "primeiro"},
+		{1, OrdinalNumber, LocalePtBR, "um", "primeiro"},
+		{21, OrdinalWeek, LocalePtBR, "vinte e uma", "vigésima primeira"},
+		{100, OrdinalNumber, LocalePtBR, "cem", "centésimo"},
+		{2024, OrdinalYear, LocalePtBR, "dois mil e vinte e quatro", "dois milésimo vigésimo quarto"},
+		{1999, OrdinalYear, LocalePtBR, "mil novecentos e noventa e nove", "milésimo nongentésimo nonagésimo nono"},
+		{16, OrdinalNumber, LocalePtPT, "dezasseis", "décimo sexto"},
+		{1, OrdinalDate, LocalePtPT, "um", "primeiro"},
+
+		{21, OrdinalDate, LocaleES, "veintiuno", "vigésimo primero"},
+		{13, OrdinalWeek, LocaleES, "trece", "decimotercera"},
+		{18, OrdinalNumber, LocaleES, "dieciocho", "decimoctavo"},
+		{21000, OrdinalNumber, LocaleES, "veintiún mil", "veintiún milésimo"},
+		{31000, OrdinalNumber, LocaleES, "treinta y un mil", "treinta y un milésimo"},
+
+		{1, OrdinalDate, LocaleFR, "premier", "premier"},
+		{1, OrdinalWeek, LocaleFR, "un", "première"},
+		{3, OrdinalNumber, LocaleFR, "trois", "troisième"},
+		{21, OrdinalNumber, LocaleFR, "vingt et un", "vingt et unième"},
+		{23, OrdinalNumber, LocaleFR, "vingt-trois", "vingt-troisième"},
+		{71, OrdinalNumber, LocaleFR, "soixante et onze", "soixante et onzième"},
+		{80, OrdinalNumber, LocaleFR, "quatre-vingts", "quatre-vingtième"},
+		{99, OrdinalNumber, LocaleFR, "quatre-vingt-dix-neuf", "quatre-vingt-dix-neuvième"},
+		{103, OrdinalNumber, LocaleFR, "cent trois", "cent troisième"},
+		{200, OrdinalNumber, LocaleFR, "deux cents", "deux centième"},
+		{80000, OrdinalNumber, LocaleFR, "quatre-vingt mille", "quatre-vingt millième"},
+
+		{1, OrdinalDate, LocaleDE, "eins", "erste"},
+		{3, OrdinalDate, LocaleDE, "drei", "dritte"},
+		{21, OrdinalDate, LocaleDE, "einundzwanzig", "einundzwanzigste"},
+		{101, OrdinalNumber, LocaleDE, "einhunderteins", "einhunderterste"},
+		{2024, OrdinalYear, LocaleDE, "zweitausendvierundzwanzig", "zweitausendvierundzwanzigste"},
+
+		{1, OrdinalDate, LocaleIT, "primo", "primo"},
+		{21, OrdinalNumber, LocaleIT, "ventuno", "ventunesimo"},
+		{23, OrdinalNumber, LocaleIT, "ventitré", "ventitreesimo"},
+		{108, OrdinalNumber, LocaleIT, "centotto", "centottesimo"},
+		{2000, OrdinalWeek, LocaleIT, "duemila", "duemillesima"},
+		{2024, OrdinalYear, LocaleIT, "duemilaventiquattro", "duemilaventiquattresimo"},
+	}
+
+	for _, tt := range tests {
+		got, err := SpellNumber(tt.n, tt.unit, tt.locale)
+		if err != nil || got != tt.cardinal {
+			t.Errorf("SpellNumber(%d, %v, %s) = %q, %v, want %q", tt.n, tt.unit, tt.locale.Code, got, err, tt.cardinal)
+		}
+		got, err = SpellOrdinal(tt.n, tt.unit, tt.locale)
+		if tt.ordinal == "" {
+			if !errors.Is(err, errors.ErrUnsupported) {
+				t.Errorf("SpellOrdinal(%d, %v, %s) error = %v, want wrapped ErrUnsupported", tt.n, tt.unit, tt.locale.Code, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.ordinal {
+			t.Errorf("SpellOrdinal(%d, %v, %s) = %q, %v, want %q", tt.n, tt.unit, tt.locale.Code, got, err, tt.ordinal)
+		}
+	}
+}
+
+func TestSpellNumberUnsupported(t *testing.T) {
+	for _, tt := range []struct {
+		n      int
+		locale *Locale
+	}{
+		{-1, nil},
+		{maxSpelledNumber + 1, nil},
+		{5, LocalePL},
+		{5, LocaleRU},
+	} {
+		if _, err := SpellNumber(tt.n, OrdinalNumber, tt.locale); !errors.Is(err, errors.ErrUnsupported) {
+			t.Errorf("SpellNumber(%d, %v) error = %v, want wrapped ErrUnsupported", tt.n, tt.locale, err)
+		}
+	}
+}
+
+func TestFormatSpelledOut(t *testing.T) {
+	date := time.Date(2024, time.March, 21, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		date     time.Time
+		locale   *Locale
+		expected string
+	}{
+		{date, nil, "the twenty-first day of March, two thousand twenty-four"},
+		{date, LocaleEnGB, "the twenty-first day of March two thousand and twenty-four"},
+		{date, LocalePtBR, "vinte e um de março de dois mil e vinte e quatro"},
+		{time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), LocalePtBR, "primeiro de janeiro de dois mil e vinte e cinco"},
+		{date, LocalePtPT, "vinte e um de março de dois mil e vinte e quatro"},
+		{date, LocaleES, "veintiuno de marzo de dos mil veinticuatro"},
+		{time.Date(1999, time.May, 1, 0, 0, 0, 0, time.UTC), LocaleFR, "le premier mai mille neuf cent quatre-vingt-dix-neuf"},
+		{date, LocaleDE, "der einundzwanzigste März zweitausendvierundzwanzig"},
+		{date, LocaleIT, "il ventuno marzo duemilaventiquattro"},
+	}
+
+	for _, tt := range tests {
+		got, err := FormatSpelledOut(tt.date, tt.locale)
+		if err != nil || got != tt.expected {
+			t.Errorf("FormatSpelledOut(%v, %v) = %q, %v, want %q", tt.date, tt.locale, got, err, tt.expected)
+		}
+	}
+
+	if _, err := FormatSpelledOut(time.Time{}, nil); !errors.Is(err, ErrZeroTime) {
+		t.Errorf("FormatSpelledOut(zero) error = %v, want wrapped ErrZeroTime", err)
+	}
+	if _, err := FormatSpelledOut(date, LocaleRU); !errors.Is(err, errors.ErrUnsupported) {
+		t.Errorf("FormatSpelledOut(ru) error = %v, want wrapped ErrUnsupported", err)
+	}
+}
+
+func TestSpelledOutTokens(t *testing.T) {
+	date := time.Date(2024, time.March, 21, 15, 5, 0, 0, time.UTC)
+	tests := []struct {
+		pattern  string
+		locale   *Locale
+		expected string
+	}{
+		{"do MMMM yyyy", nil, "21st March 2024"},
+		{"dN 'of' MMMM", nil, "twenty-first of March"},
+		{"Hn 'hours' mn", nil, "fifteen hours five"},
+		{"Qo 'quarter', 'the' QN", nil, "1st quarter, the first"},
+		{"dn 'de' MMMM", LocalePtBR, "vinte e um de março"},
+		{"'la' wN 'semaine'", LocaleFR, "la douzième semaine"},
+		// Locales without a SpellOut rule fall back to digits and ordinals.
+		{"dn MMMM", LocaleRU, "21 марта"},
+		{"dN MMMM", LocaleRU, "21-е марта"},
+	}
+
+	for _, tt := range tests {
+		got, err := FormatTokens(date, tt.pattern, &FormatTokensOptions{Locale: tt.locale})
+		if err != nil || got != tt.expected {
+			t.Errorf("FormatTokens(%q) = %q, %v, want %q", tt.pattern, got, err, tt.expected)
+		}
+	}
+
+	var tokenErr *TokenError
+	_, err := ParseTokens("twenty-first", "dN", date, nil)
+	if !errors.As(err, &tokenErr) || !errors.Is(err, ErrUnknownToken) || tokenErr.Suggestion != "do" {
+		t.Errorf("ParseTokens(dN) error = %v, want a *TokenError suggesting do", err)
+	}
+}
+
+func BenchmarkFormatSpelledOut(b *testing.B) {
+	date := time.Date(2024, time.March, 21, 0, 0, 0, 0, time.UTC)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = FormatSpelledOut(date, LocalePtBR)
+	}
+}
@@ -0,0 +1,674 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// strftimeConversions lists every conversion character of Strftime and Strptime.
+const strftimeConversions = "aAbBcCdDeFfgGhHIjklmMnNpPrRsStTuUVwWxXyYzZ%+"
+
+// strftimeComposites are the conversions that stand for a whole pattern,
+// written as in the C and POSIX locale.
+var strftimeComposites = map[byte]string{
+	'c': "%a %b %e %H:%M:%S %Y",
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'r': "%I:%M:%S %p",
+	'R': "%H:%M",
+	'T': "%H:%M:%S",
+	'x': "%m/%d/%y",
+	'X': "%H:%M:%S",
+	'+': "%a %b %e %H:%M:%S %Z %Y",
+}
+
+// strftimeDirective is a single % conversion of a strftime pattern, with its
+// glibc flags and field width.
+type strftimeDirective struct {
+	conv   byte // Conversion character, such as 'Y'
+	pad    byte // '-', '_' or '0' from the flags, or 0 for the default padding
+	upper  bool // '^' flag
+	swap   bool // '#' flag
+	width  int  // Field width, or 0 for the default
+	colons int  // Number of ':' before z
+}
+
+// scanStrftimeDirective reads the directive whose '%' is at pattern[i] and
+// returns it with the offset just after it. The E and O modifiers of POSIX
+// are accepted and ignored.
+func scanStrftimeDirective(pattern string, i int) (strftimeDirective, int, error) {
+	var d strftimeDirective
+	j := i + 1
+	for ; j < len(pattern) && strings.IndexByte("-_0^#", pattern[j]) >= 0; j++ {
+		switch pattern[j] {
+		case '^':
+			d.upper = true
+		case '#':
+			d.swap = true
+		default:
+			d.pad = pattern[j]
+		}
+	}
+	for ; j < len(pattern) && isDigit(pattern[j]); j++ {
+		d.width = d.width*10 + int(pattern[j]-'0')
+	}
+	if j < len(pattern) && (pattern[j] == 'E' || pattern[j] == 'O') {
+		j++
+	}
+	for ; j < len(pattern) && pattern[j] == ':'; j++ {
+		d.colons++
+	}
+	if j == len(pattern) {
+		return d, j, &TokenError{Pattern: pattern, Token: pattern[i:], Offset: i, Err: ErrUnknownToken}
+	}
+	d.conv = pattern[j]
+	j++
+	if strings.IndexByte(strftimeConversions, d.conv) < 0 || (d.colons > 0 && d.conv != 'z') || d.colons > 2 {
+		return d, j, &TokenError{Pattern: pattern, Token: pattern[i:j], Offset: i, Err: ErrUnknownToken}
+	}
+	return d, j, nil
+}
+
+// Strftime formats a time with a C strftime pattern, covering the POSIX
+// conversions and the glibc extensions:
+//
+//	%a %A %b %B %h  weekday and month names (Thu, Thursday, Mar, March)
+//	%C %y %Y        century, two-digit year, year
+//	%G %g %V        ISO 8601 week-numbering year and week, as GetISOWeekYear and GetISOWeek
+//	%U %W           week of the year starting on Sunday or Monday, 00 before the first one
+//	%m %d %e %j     month, day (zero- and space-padded) and day of the year
+//	%u %w           weekday from Monday=1 and from Sunday=0
+//	%H %I %k %l     hour (00-23, 01-12, space-padded 0-23 and 1-12)
+//	%M %S %f %N     minute, second, microseconds (Python) and nanoseconds (GNU date)
+//	%p %P           AM/PM and am/pm
+//	%z %:z %::z %Z  offset (+0100, +01:00, +01:00:00) and zone abbreviation
+//	%s              Unix timestamp in seconds
+//	%c %D %F %r %R %T %x %X %+  composite patterns of the C locale
+//	%n %t %%        newline, tab and a literal %
+//
+// Flags between the % and the conversion follow glibc: "-" removes padding
+// (%-d is "5"), "_" pads with spaces, "0" with zeros, "^" upper-cases and "#"
+// swaps the case of names; a decimal width (%10Y) sets the minimum width,
+// or the number of digits for %N. Names are in English, as in the C locale,
+// and %Y, %G and %s are not padded, as in glibc.
+// Returns a *TokenError wrapping ErrUnknownToken for unknown conversions,
+// or an error wrapping ErrZeroTime or ErrEmptyFormat.
+//
+// Example:
+//
+//	date := time.Date(2024, time.March, 5, 14, 5, 0, 0, time.UTC)
+//	Strftime(date, "%Y-%m-%d %H:%M:%S") // "2024-03-05 14:05:00"
+//	Strftime(date, "%a %-d %b, %I:%M %p") // "Tue 5 Mar, 02:05 PM"
+//	Strftime(date, "%G-W%V-%u")           // "2024-W10-2"
+func Strftime(t time.Time, pattern string) (string, error) {
+	if t.IsZero() {
+		return "", fmt.Errorf("cannot format: %w", ErrZeroTime)
+	}
+	if pattern == "" {
+		return "", fmt.Errorf("cannot format: %w", ErrEmptyFormat)
+	}
+
+	dst, err := appendStrftime(make([]byte, 0, len(pattern)+16), t, pattern)
+	if err != nil {
+		return "", err
+	}
+	return string(dst), nil
+}
+
+// appendStrftime appends t formatted with a strftime pattern to dst.
+func appendStrftime(dst []byte, t time.Time, pattern string) ([]byte, error) {
+	for i := 0; i < len(pattern); {
+		if pattern[i] != '%' {
+			j := strings.IndexByte(pattern[i:], '%')
+			if j < 0 {
+				j = len(pattern) - i
+			}
+			dst = append(dst, pattern[i:i+j]...)
+			i += j
+			continue
+		}
+
+		d, next, err := scanStrftimeDirective(pattern, i)
+		if err != nil {
+			return nil, err
+		}
+		i = next
+
+		start := len(dst)
+		if composite, ok := strftimeComposites[d.conv]; ok {
+			dst, _ = appendStrftime(dst, t, composite)
+		} else {
+			dst = appendStrftimeField(dst, t, d)
+		}
+		if d.upper {
+			upper := strings.ToUpper(string(dst[start:]))
+			dst = append(dst[:start], upper...)
+		}
+	}
+	return dst, nil
+}
+
+// appendStrftimeField appends a single non-composite conversion.
+func appendStrftimeField(dst []byte, t time.Time, d strftimeDirective) []byte {
+	number := func(value, width int, pad byte) []byte {
+		return appendStrftimeNumber(dst, value, width, pad, d)
+	}
+	text := func(s string, upperWithSwap bool) []byte {
+		if d.swap {
+			if upperWithSwap {
+				s = strings.ToUpper(s)
+			} else {
+				s = strings.ToLower(s)
+			}
+		}
+		for n := len(s); n < d.width; n++ {
+			if d.pad == '0' {
+				dst = append(dst, '0')
+			} else {
+				dst = append(dst, ' ')
+			}
+		}
+		return append(dst, s...)
+	}
+
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	hour12 := hour % 12
+	if hour12 == 0 {
+		hour12 = 12
+	}
+	weekday := int(t.Weekday())
+	yearDay := t.YearDay() - 1
+
+	switch d.conv {
+	case 'a':
+		return text(LocaleEnUS.WeekdaysAbbreviated[weekday], true)
+	case 'A':
+		return text(LocaleEnUS.WeekdaysWide[weekday], true)
+	case 'b', 'h':
+		return text(LocaleEnUS.MonthsAbbreviated[month-1], true)
+	case 'B':
+		return text(LocaleEnUS.MonthsWide[month-1], true)
+	case 'p':
+		return text(LocaleEnUS.MeridiemsAbbreviated[hour/12], false)
+	case 'P':
+		return text(strings.ToLower(LocaleEnUS.MeridiemsAbbreviated[hour/12]), false)
+	case 'Z':
+		name, _ := t.Zone()
+		return text(name, false)
+	case 'n':
+		return append(dst, '\n')
+	case 't':
+		return append(dst, '\t')
+	case '%':
+		return append(dst, '%')
+	case 'C':
+		return number(floorDiv(year, 100), 2, '0')
+	case 'y':
+		return number(year-floorDiv(year, 100)*100, 2, '0')
+	case 'Y':
+		return number(year, 1, '0')
+	case 'G':
+		return number(GetISOWeekYear(t), 1, '0')
+	case 'g':
+		isoYear := GetISOWeekYear(t)
+		return number(isoYear-floorDiv(isoYear, 100)*100, 2, '0')
+	case 'V':
+		return number(GetISOWeek(t), 2, '0')
+	case 'U':
+		return number((yearDay+7-weekday)/7, 2, '0')
+	case 'W':
+		return number((yearDay+7-(weekday+6)%7)/7, 2, '0')
+	case 'm':
+		return number(int(month), 2, '0')
+	case 'd':
+		return number(day, 2, '0')
+	case 'e':
+		return number(day, 2, ' ')
+	case 'j':
+		return number(yearDay+1, 3, '0')
+	case 'u':
+		return number((weekday+6)%7+1, 1, '0')
+	case 'w':
+		return number(weekday, 1, '0')
+	case 'H':
+		return number(hour, 2, '0')
+	case 'k':
+		return number(hour, 2, ' ')
+	case 'I':
+		return number(hour12, 2, '0')
+	case 'l':
+		return number(hour12, 2, ' ')
+	case 'M':
+		return number(minute, 2, '0')
+	case 'S':
+		return number(second, 2, '0')
+	case 's':
+		return number(int(t.Unix()), 1, '0')
+	case 'f':
+		return appendInt(dst, t.Nanosecond()/int(time.Microsecond), 6)
+	case 'N':
+		digits := 9
+		if d.width > 0 && d.width < 9 {
+			digits = d.width
+		}
+		value := t.Nanosecond()
+		for i := digits; i < 9; i++ {
+			value /= 10
+		}
+		return appendInt(dst, value, digits)
+	case 'z':
+		_, offset := t.Zone()
+		return appendStrftimeOffset(dst, offset, d.colons)
+	}
+	return dst
+}
+
+// appendStrftimeNumber appends value padded to the default width with the
+// default pad character, unless the directive's flags or width override them.
+func appendStrftimeNumber(dst []byte, value, width int, pad byte, d strftimeDirective) []byte {
+	switch d.pad {
+	case '-':
+		width = 0
+	case '_':
+		pad = ' '
+	case '0':
+		pad = '0'
+	}
+	if d.width > 0 && d.pad != '-' {
+		width = d.width
+	}
+
+	if pad == '0' {
+		return appendInt(dst, value, width)
+	}
+	// Space padding goes before the sign, so the digits are shifted right.
+	start := len(dst)
+	dst = appendInt(dst, value, 1)
+	if n := len(dst) - start; n < width {
+		for range width - n {
+			dst = append(dst, ' ')
+		}
+		copy(dst[start+width-n:], dst[start:start+n])
+		for i := start; i < start+width-n; i++ {
+			dst[i] = ' '
+		}
+	}
+	return dst
+}
+
+// appendStrftimeOffset appends a UTC offset as +hhmm, or +hh:mm and
+// +hh:mm:ss with one or two colons.
+func appendStrftimeOffset(dst []byte, offset, colons int) []byte {
+	sign := byte('+')
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	dst = append(dst, sign)
+	dst = appendInt(dst, offset/3600, 2)
+	if colons > 0 {
+		dst = append(dst, ':')
+	}
+	dst = appendInt(dst, offset/60%60, 2)
+	if colons > 1 {
+		dst = append(dst, ':')
+		dst = appendInt(dst, offset%60, 2)
+	}
+	return dst
+}
+
+// floorDiv divides rounding toward negative infinity, so that the century
+// and two-digit year of years before 1 AD stay in range.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// strptimeFields holds the fields read by Strptime; -1 means not read.
+type strptimeFields struct {
+	year, century, yearInCentury int
+	month, day, yearDay          int
+	hour, hour12, pm             int
+	minute, second, nanos        int
+	weekday                      int // Sunday=0
+	weekSunday, weekMonday       int // %U and %W
+	isoYear, isoWeek             int
+	offset                       int
+	hasOffset                    bool
+	unix                         int64
+	hasUnix                      bool
+}
+
+// Strptime parses a string with a C strptime pattern, accepting the same
+// conversions and flags as Strftime:
+//   - whitespace in the pattern, %n and %t match any amount of whitespace,
+//     including none, and numbers may be preceded by spaces;
+//   - %a and %A accept abbreviated and full weekday names, %b, %B and %h
+//     abbreviated and full month names, all case-insensitively;
+//   - %y maps 69-99 to 1969-1999 and 00-68 to 2000-2068, unless %C gives the century;
+//   - %j, %U or %W with a weekday, and %G with %V and a weekday set the date;
+//   - %z accepts Z, +hh, +hhmm and +hh:mm, and %Z accepts a zone name,
+//     where only UTC and GMT set the offset;
+//   - %s sets the instant, overriding every other field.
+//
+// Missing fields default to January 1, 1900 at midnight, as in Python.
+// The result is in loc (UTC if nil); when the input carries an offset the
+// instant is converted to loc. Native digits are read as ASCII digits.
+// Returns a *ParseError if the input does not match the pattern, a
+// *TokenError for unknown conversions, or an error wrapping ErrEmptyInput
+// or ErrEmptyFormat.
+//
+// Example:
+//
+//	Strptime("2024-03-05 14:05:00", "%Y-%m-%d %H:%M:%S", nil) // 2024-03-05 14:05:00 UTC
+//	Strptime("Tue  5 Mar 2:05 PM", "%a %e %b %I:%M %p", nil)  // 1900-03-05 14:05:00 UTC
+//	Strptime("2024-W10-2", "%G-W%V-%u", nil)                 // 2024-03-05 00:00:00 UTC
+func Strptime(input, pattern string, loc *time.Location) (time.Time, error) {
+	if input == "" {
+		return time.Time{}, fmt.Errorf("cannot parse date string: %w", ErrEmptyInput)
+	}
+	if pattern == "" {
+		return time.Time{}, fmt.Errorf("cannot parse date string: %w", ErrEmptyFormat)
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	f := strptimeFields{
+		year: -1, century: -1, yearInCentury: -1, month: -1, day: -1, yearDay: -1,
+		hour: -1, hour12: -1, pm: -1, minute: -1, second: -1, nanos: -1, weekday: -1,
+		weekSunday: -1, weekMonday: -1, isoYear: -1, isoWeek: -1,
+	}
+	in := &tokenInput{s: NormalizeDigits(input)}
+	if err := parseStrptime(in, pattern, &f); err != nil {
+		var tokenErr *TokenError
+		if errors.As(err, &tokenErr) {
+			return time.Time{}, err
+		}
+		return time.Time{}, tokenParseError(input, pattern, in.pos, err.Error())
+	}
+	if in.pos < len(in.s) {
+		return time.Time{}, tokenParseError(input, pattern, in.pos, "unconverted input remains")
+	}
+
+	if f.hasUnix {
+		return time.Unix(f.unix, 0).In(loc), nil
+	}
+	t, err := f.time(loc)
+	if err != nil {
+		return time.Time{}, tokenParseError(input, pattern, 0, err.Error())
+	}
+	return t, nil
+}
+
+// parseStrptime matches the input against a pattern, filling f.
+func parseStrptime(in *tokenInput, pattern string, f *strptimeFields) error {
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		if isSpace(c) {
+			in.skipSpaces()
+			i++
+			continue
+		}
+		if c != '%' {
+			if in.pos >= len(in.s) || in.s[in.pos] != c {
+				return fmt.Errorf("expected %q", c)
+			}
+			in.pos++
+			i++
+			continue
+		}
+
+		d, next, err := scanStrftimeDirective(pattern, i)
+		if err != nil {
+			return err
+		}
+		i = next
+		if composite, ok := strftimeComposites[d.conv]; ok {
+			if err := parseStrptime(in, composite, f); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := parseStrptimeField(in, d.conv, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseStrptimeField reads a single non-composite conversion.
+func parseStrptimeField(in *tokenInput, conv byte, f *strptimeFields) error {
+	number := func(maxDigits, lo, hi int) (int, error) {
+		in.skipSpaces()
+		value, ok := in.digits(1, maxDigits)
+		if !ok {
+			return 0, fmt.Errorf("expected a number for %%%c", conv)
+		}
+		if value < lo || value > hi {
+			return 0, fmt.Errorf("%%%c value %d out of range %d-%d", conv, value, lo, hi)
+		}
+		return value, nil
+	}
+	name := func(lists ...[]string) (int, error) {
+		index, ok := in.name(lists...)
+		if !ok {
+			return 0, fmt.Errorf("expected a name for %%%c", conv)
+		}
+		return index, nil
+	}
+
+	var err error
+	switch conv {
+	case 'a', 'A':
+		f.weekday, err = name(LocaleEnUS.WeekdaysWide[:], LocaleEnUS.WeekdaysAbbreviated[:])
+	case 'b', 'B', 'h':
+		var index int
+		index, err = name(LocaleEnUS.MonthsWide[:], LocaleEnUS.MonthsAbbreviated[:])
+		f.month = index + 1
+	case 'p', 'P':
+		f.pm, err = name(LocaleEnUS.MeridiemsAbbreviated[:], LocaleEnUS.MeridiemsWide[:])
+	case 'Z':
+		start := in.pos
+		for in.pos < len(in.s) && isASCIILetter(in.s[in.pos]) {
+			in.pos++
+		}
+		switch zone := strings.ToUpper(in.s[start:in.pos]); {
+		case zone == "":
+			err = errors.New("expected a zone name for %Z")
+		case (zone == "UTC" || zone == "GMT" || zone == "UT" || zone == "Z") && !f.hasOffset:
+			f.offset, f.hasOffset = 0, true
+		}
+	case 'z':
+		f.offset, err = in.strptimeOffset()
+		f.hasOffset = err == nil
+	case 'n', 't':
+		in.skipSpaces()
+	case '%':
+		if in.pos >= len(in.s) || in.s[in.pos] != '%' {
+			err = errors.New(`expected "%"`)
+		} else {
+			in.pos++
+		}
+	case 'C':
+		f.century, err = number(2, 0, 99)
+	case 'y':
+		f.yearInCentury, err = number(2, 0, 99)
+	case 'Y':
+		f.year, err = number(4, 0, 9999)
+	case 'G':
+		f.isoYear, err = number(4, 0, 9999)
+	case 'g':
+		var yy int
+		yy, err = number(2, 0, 99)
+		f.isoYear = pivotTwoDigitYear(yy)
+	case 'V':
+		f.isoWeek, err = number(2, 1, 53)
+	case 'U':
+		f.weekSunday, err = number(2, 0, 53)
+	case 'W':
+		f.weekMonday, err = number(2, 0, 53)
+	case 'm':
+		f.month, err = number(2, 1, 12)
+	case 'd', 'e':
+		f.day, err = number(2, 1, 31)
+	case 'j':
+		f.yearDay, err = number(3, 1, 366)
+	case 'u':
+		var weekday int
+		weekday, err = number(1, 1, 7)
+		f.weekday = weekday % 7
+	case 'w':
+		f.weekday, err = number(1, 0, 6)
+	case 'H', 'k':
+		f.hour, err = number(2, 0, 23)
+	case 'I', 'l':
+		f.hour12, err = number(2, 1, 12)
+	case 'M':
+		f.minute, err = number(2, 0, 59)
+	case 'S':
+		f.second, err = number(2, 0, 61)
+	case 'f', 'N':
+		maxDigits := 6
+		if conv == 'N' {
+			maxDigits = 9
+		}
+		start := in.pos
+		var fraction int
+		fraction, err = number(maxDigits, 0, 999999999)
+		for n := in.pos - start; err == nil && n < 9; n++ {
+			fraction *= 10
+		}
+		f.nanos = fraction
+	case 's':
+		in.skipSpaces()
+		var ok bool
+		if f.unix, ok = in.signedDigits(); !ok {
+			err = errors.New("expected a Unix timestamp for %s")
+		}
+		f.hasUnix = ok
+	}
+	return err
+}
+
+// pivotTwoDigitYear maps 69-99 to 1969-1999 and 00-68 to 2000-2068, as POSIX strptime does.
+func pivotTwoDigitYear(yy int) int {
+	if yy < 69 {
+		return 2000 + yy
+	}
+	return 1900 + yy
+}
+
+// time assembles the fields into a time in loc, or in the parsed offset.
+func (f *strptimeFields) time(loc *time.Location) (time.Time, error) {
+	year := 1900
+	switch {
+	case f.year >= 0:
+		year = f.year
+	case f.century >= 0 && f.yearInCentury >= 0:
+		year = f.century*100 + f.yearInCentury
+	case f.yearInCentury >= 0:
+		year = pivotTwoDigitYear(f.yearInCentury)
+	case f.century >= 0:
+		year = f.century * 100
+	}
+
+	hour := max(f.hour, 0)
+	if f.hour12 >= 0 {
+		hour = f.hour12 % 12
+		if f.pm == 1 {
+			hour += 12
+		}
+	}
+	location := loc
+	if f.hasOffset {
+		location = time.FixedZone("", f.offset)
+	}
+	clock := func(date time.Time) time.Time {
+		return time.Date(date.Year(), date.Month(), date.Day(), hour, max(f.minute, 0), max(f.second, 0), max(f.nanos, 0), location).In(loc)
+	}
+
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	switch {
+	case f.isoWeek >= 0 || f.isoYear >= 0:
+		if f.isoWeek < 0 || f.isoYear < 0 || f.weekday < 0 {
+			return time.Time{}, errors.New("%G and %V must be used together with a weekday")
+		}
+		jan4 := time.Date(f.isoYear, time.January, 4, 0, 0, 0, 0, time.UTC)
+		week1 := AddDays(jan4, -(int(jan4.Weekday())+6)%7)
+		return clock(AddDays(week1, (f.isoWeek-1)*7+(f.weekday+6)%7)), nil
+
+	case f.yearDay >= 0 && (f.month < 0 || f.day < 0):
+		date := AddDays(jan1, f.yearDay-1)
+		if date.Year() != year {
+			return time.Time{}, fmt.Errorf("day of year %d out of range for %d", f.yearDay, year)
+		}
+		return clock(date), nil
+
+	case (f.weekSunday >= 0 || f.weekMonday >= 0) && f.weekday >= 0 && (f.month < 0 || f.day < 0):
+		firstWeekday := int(jan1.Weekday())
+		var days int
+		if f.weekSunday >= 0 {
+			days = (7-firstWeekday)%7 + (f.weekSunday-1)*7 + f.weekday
+		} else {
+			days = (8-firstWeekday)%7 + (f.weekMonday-1)*7 + (f.weekday+6)%7
+		}
+		return clock(AddDays(jan1, days)), nil
+	}
+
+	month, day := max(f.month, 1), max(f.day, 1)
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day {
+		return time.Time{}, fmt.Errorf("day %d out of range for %s %d", day, time.Month(month), year)
+	}
+	return clock(date), nil
+}
+
+// skipSpaces consumes any ASCII whitespace.
+func (in *tokenInput) skipSpaces() {
+	for in.pos < len(in.s) && isSpace(in.s[in.pos]) {
+		in.pos++
+	}
+}
+
+// strptimeOffset consumes a UTC offset written as Z, ±hh, ±hhmm or ±hh:mm
+// and returns it in seconds.
+func (in *tokenInput) strptimeOffset() (int, error) {
+	if in.pos < len(in.s) && (in.s[in.pos] == 'Z' || in.s[in.pos] == 'z') {
+		in.pos++
+		return 0, nil
+	}
+	if in.pos >= len(in.s) || (in.s[in.pos] != '+' && in.s[in.pos] != '-') {
+		return 0, errors.New(`expected an offset such as "+0100" for %z`)
+	}
+	sign := 1
+	if in.s[in.pos] == '-' {
+		sign = -1
+	}
+	in.pos++
+	hours, ok := in.digits(2, 2)
+	if !ok {
+		return 0, errors.New("expected offset hours for %z")
+	}
+	if in.pos < len(in.s) && in.s[in.pos] == ':' {
+		in.pos++
+	}
+	minutes := 0
+	if in.pos < len(in.s) && isDigit(in.s[in.pos]) {
+		if minutes, ok = in.digits(2, 2); !ok || minutes > 59 {
+			return 0, errors.New("expected offset minutes for %z")
+		}
+	}
+	return sign * (hours*3600 + minutes*60), nil
+}
+
+// isSpace reports whether c is ASCII whitespace.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
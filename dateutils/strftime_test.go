@@ -0,0 +1,259 @@
+package dateutils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestStrftime(t *testing.T) {
+	date := time.Date(2024, time.March, 5, 14, 5, 7, 123456789, time.UTC)
+	saoPaulo := time.Date(2024, time.March, 5, 9, 0, 0, 0, time.FixedZone("BRT", -3*3600))
+
+	tests := []struct {
+		name    string
+		t       time.Time
+		pattern string
+		want    string
+	}{
+		{"Date and time", date, "%Y-%m-%d %H:%M:%S", "2024-03-05 14:05:07"},
+		{"Names", date, "%a %A %b %B %h", "Tue Tuesday Mar March Mar"},
+		{"Years", date, "%C %y %Y", "20 24 2024"},
+		{"Weeks", date, "%G %g %V %U %W", "2024 24 10 09 10"},
+		{"Day of year and weekday", date, "%j %u %w", "065 2 2"},
+		{"Twelve-hour clock", date, "%I %l %p %P", "02  2 PM pm"},
+		{"Space-padded hour", saoPaulo, "%k|%_H|%-H", " 9| 9|9"},
+		{"Composite date and time", date, "%c", "Tue Mar  5 14:05:07 2024"},
+		{"Composite patterns", date, "%D %F %r %R %T %x %X", "03/05/24 2024-03-05 02:05:07 PM 14:05 14:05:07 03/05/24 14:05:07"},
+		{"Date with zone", date, "%+", "Tue Mar  5 14:05:07 UTC 2024"},
+		{"Unpadded day", date, "%-d/%-m", "5/3"},
+		{"Space-padded day", date, "%e|%_d", " 5| 5"},
+		{"Zero-padded space-padded day", date, "%0e", "05"},
+		{"Width", date, "%10Y|%_5m|%-10d", "0000002024|    3|5"},
+		{"Uppercase and swapped case", date, "%^a %^B %#b %#p", "TUE MARCH MAR pm"},
+		{"Uppercase composite", date, "%^c", "TUE MAR  5 14:05:07 2024"},
+		{"Fractions", date, "%f %N %3N", "123456 123456789 123"},
+		{"Offsets", saoPaulo, "%z %:z %::z %Z", "-0300 -03:00 -03:00:00 BRT"},
+		{"Unix timestamp", date, "%s", "1709647507"},
+		{"E and O modifiers", date, "%Ey %Od", "24 05"},
+		{"Escapes", date, "100%% %n%t", "100% \n\t"},
+		{"Midnight", time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC), "%I %p %l", "12 AM 12"},
+		{"Year before 1000", time.Date(987, 6, 1, 0, 0, 0, 0, time.UTC), "%Y %C %y", "987 09 87"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Strftime(tt.t, tt.pattern)
+			if err != nil {
+				t.Fatalf("Strftime() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Strftime(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrftimeWeeksAgreeWithTokens(t *testing.T) {
+	start := time.Date(2019, time.December, 20, 0, 0, 0, 0, time.UTC)
+	for day := 0; day < 3*366; day += 3 {
+		d := start.AddDate(0, 0, day)
+		got, err := Strftime(d, "%G-W%V-%u")
+		if err != nil {
+			t.Fatalf("Strftime() error = %v", err)
+		}
+		want, _ := FormatTokens(d, "RRRR-'W'II-i", nil)
+		if got != want {
+			t.Errorf("Strftime(%s) = %q, FormatTokens = %q", d.Format("2006-01-02"), got, want)
+		}
+		if week := fmt.Sprintf("%02d", GetISOWeek(d)); got[6:8] != week {
+			t.Errorf("Strftime(%s) week = %s, GetISOWeek = %s", d.Format("2006-01-02"), got[6:8], week)
+		}
+	}
+}
+
+func TestStrftimeWeekBoundaries(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "2020 53 00 00 5 5 001"},
+		{time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), "2022 52 01 00 7 0 001"},
+		{time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), "2025 01 52 53 1 1 365"},
+		{time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC), "2026 01 01 00 7 0 004"},
+	}
+	for _, tt := range tests {
+		got, _ := Strftime(tt.date, "%G %V %U %W %u %w %j")
+		if got != tt.want {
+			t.Errorf("Strftime(%s) = %q, want %q", tt.date.Format("2006-01-02"), got, tt.want)
+		}
+	}
+}
+
+func TestStrftimeErrors(t *testing.T) {
+	date := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	if _, err := Strftime(time.Time{}, "%Y"); !errors.Is(err, ErrZeroTime) {
+		t.Errorf("zero time error = %v, want ErrZeroTime", err)
+	}
+	if _, err := Strftime(date, ""); !errors.Is(err, ErrEmptyFormat) {
+		t.Errorf("empty pattern error = %v, want ErrEmptyFormat", err)
+	}
+	for _, pattern := range []string{"%Q", "%Y %", "%:Y", "%:::z"} {
+		_, err := Strftime(date, pattern)
+		var tokenErr *TokenError
+		if !errors.As(err, &tokenErr) || !errors.Is(err, ErrUnknownToken) {
+			t.Errorf("Strftime(%q) error = %v, want *TokenError wrapping ErrUnknownToken", pattern, err)
+		}
+	}
+}
+
+func TestStrptime(t *testing.T) {
+	saoPaulo := time.FixedZone("BRT", -3*3600)
+
+	tests := []struct {
+		name    string
+		input   string
+		pattern string
+		loc     *time.Location
+		want    time.Time
+	}{
+		{"Date and time", "2024-03-05 14:05:07", "%Y-%m-%d %H:%M:%S", nil,
+			time.Date(2024, 3, 5, 14, 5, 7, 0, time.UTC)},
+		{"Compact", "20240305T140507", "%Y%m%dT%H%M%S", nil,
+			time.Date(2024, 3, 5, 14, 5, 7, 0, time.UTC)},
+		{"Defaults to 1900", "14:05", "%H:%M", nil,
+			time.Date(1900, 1, 1, 14, 5, 0, 0, time.UTC)},
+		{"Names", "tuesday, 5 MARCH 2024", "%A, %d %B %Y", nil,
+			time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"Abbreviated names for full directives", "Tue 5 Mar 2024", "%A %d %B %Y", nil,
+			time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"Space-padded day and flags", "Tue  5 Mar 2:05 PM", "%a %e %b %-I:%M %p", nil,
+			time.Date(1900, 3, 5, 14, 5, 0, 0, time.UTC)},
+		{"Twelve AM", "12:30 am", "%I:%M %p", nil,
+			time.Date(1900, 1, 1, 0, 30, 0, 0, time.UTC)},
+		{"Two-digit year 2000s", "03/05/24", "%D", nil,
+			time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"Two-digit year 1900s", "03/05/69", "%m/%d/%y", nil,
+			time.Date(1969, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"Century and year", "19 24", "%C %y", nil,
+			time.Date(1924, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"Composite", "Tue Mar  5 14:05:07 2024", "%c", nil,
+			time.Date(2024, 3, 5, 14, 5, 7, 0, time.UTC)},
+		{"Day of year", "2024-060", "%Y-%j", nil,
+			time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"ISO week date", "2020-W53-5", "%G-W%V-%u", nil,
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"Sunday weeks", "2024 10 2", "%Y %U %w", nil,
+			time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC)},
+		{"Monday weeks", "2024 10 2", "%Y %W %w", nil,
+			time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"Microseconds", "14:05:07.5", "%H:%M:%S.%f", nil,
+			time.Date(1900, 1, 1, 14, 5, 7, 500000000, time.UTC)},
+		{"Nanoseconds", "07.123456789", "%S.%N", nil,
+			time.Date(1900, 1, 1, 0, 0, 7, 123456789, time.UTC)},
+		{"Offset converted to location", "2024-03-05 14:05 +0530", "%Y-%m-%d %H:%M %z", nil,
+			time.Date(2024, 3, 5, 8, 35, 0, 0, time.UTC)},
+		{"Offset with colon", "2024-03-05T14:05:07-03:00", "%Y-%m-%dT%H:%M:%S%:z", nil,
+			time.Date(2024, 3, 5, 17, 5, 7, 0, time.UTC)},
+		{"Z offset", "2024-03-05 14:05Z", "%F %R%z", nil,
+			time.Date(2024, 3, 5, 14, 5, 0, 0, time.UTC)},
+		{"Zone name", "2024-03-05 14:05 GMT", "%F %R %Z", saoPaulo,
+			time.Date(2024, 3, 5, 11, 5, 0, 0, saoPaulo)},
+		{"Location without offset", "2024-03-05 14:05", "%F %R", saoPaulo,
+			time.Date(2024, 3, 5, 14, 5, 0, 0, saoPaulo)},
+		{"Unix timestamp", "1709647507", "%s", nil,
+			time.Date(2024, 3, 5, 14, 5, 7, 0, time.UTC)},
+		{"Flexible whitespace", "2024-03-05   14:05", "%F %R", nil,
+			time.Date(2024, 3, 5, 14, 5, 0, 0, time.UTC)},
+		{"Literal percent", "50% 2024", "50%% %Y", nil,
+			time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"Native digits", "٢٠٢٤-٠٣-٠٥", "%F", nil,
+			time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Strptime(tt.input, tt.pattern, tt.loc)
+			if err != nil {
+				t.Fatalf("Strptime() error = %v", err)
+			}
+			if !got.Equal(tt.want) || got.Location().String() != tt.want.Location().String() {
+				t.Errorf("Strptime(%q, %q) = %v, want %v", tt.input, tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrptimeRoundTrip(t *testing.T) {
+	patterns := []string{"%c", "%F %T", "%G-W%V-%u %H:%M", "%Y-%j", "%a %d %b %Y %I:%M:%S %p %z", "%Y %W %w"}
+	start := time.Date(2023, time.December, 25, 13, 45, 30, 0, time.UTC)
+	for day := 0; day < 400; day += 7 {
+		date := start.AddDate(0, 0, day)
+		for _, pattern := range patterns {
+			s, err := Strftime(date, pattern)
+			if err != nil {
+				t.Fatalf("Strftime(%q) error = %v", pattern, err)
+			}
+			got, err := Strptime(s, pattern, nil)
+			if err != nil {
+				t.Fatalf("Strptime(%q, %q) error = %v", s, pattern, err)
+			}
+			if !IsSameDay(got, date) {
+				t.Errorf("Strptime(%q, %q) = %v, want the day of %v", s, pattern, got, date)
+			}
+		}
+	}
+}
+
+func TestStrptimeErrors(t *testing.T) {
+	if _, err := Strptime("", "%Y", nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("empty input error = %v, want ErrEmptyInput", err)
+	}
+	if _, err := Strptime("2024", "", nil); !errors.Is(err, ErrEmptyFormat) {
+		t.Errorf("empty pattern error = %v, want ErrEmptyFormat", err)
+	}
+	if _, err := Strptime("2024", "%Q", nil); !errors.Is(err, ErrUnknownToken) {
+		t.Errorf("unknown directive error = %v, want ErrUnknownToken", err)
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		pattern string
+	}{
+		{"Literal mismatch", "2024/03/05", "%Y-%m-%d"},
+		{"Month out of range", "2024-13-05", "%Y-%m-%d"},
+		{"Day out of range for month", "2023-02-29", "%Y-%m-%d"},
+		{"Day of year out of range", "2023-366", "%Y-%j"},
+		{"Unknown name", "Foo 2024", "%b %Y"},
+		{"Trailing input", "2024-03-05 extra", "%Y-%m-%d"},
+		{"Missing input", "2024-03", "%Y-%m-%d"},
+		{"ISO week without weekday", "2024-W10", "%G-W%V"},
+		{"ISO week without year", "W10-2", "W%V-%u"},
+		{"Bad offset", "14:05 0530", "%H:%M %z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Strptime(tt.input, tt.pattern, nil)
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Strptime(%q, %q) error = %v, want *ParseError", tt.input, tt.pattern, err)
+			}
+		})
+	}
+}
+
+func BenchmarkStrftime(b *testing.B) {
+	date := time.Date(2024, time.March, 5, 14, 5, 7, 0, time.UTC)
+	for i := 0; i < b.N; i++ {
+		_, _ = Strftime(date, "%a %e %b %Y %H:%M:%S %z")
+	}
+}
+
+func BenchmarkStrptime(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Strptime("Tue  5 Mar 2024 14:05:07 +0100", "%a %e %b %Y %H:%M:%S %z", nil)
+	}
+}